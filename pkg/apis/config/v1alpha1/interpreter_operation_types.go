@@ -0,0 +1,33 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// InterpreterOperation describes the behavior of a ResourceInterpreter hook, as either a built-in
+// implementation, a Lua customization declared by a ResourceInterpreterCustomization, or a webhook
+// declared by a ResourceInterpreterWebhookConfiguration.
+type InterpreterOperation string
+
+const (
+	// InterpreterOperationInterpretReplica indicates a hook that figures out the replica number and the
+	// required resources of each replica, declared by the resource template.
+	InterpreterOperationInterpretReplica InterpreterOperation = "InterpretReplica"
+
+	// InterpreterOperationGetFollowers indicates a hook that discovers the other resource templates (e.g. a
+	// Deployment's ConfigMap/Secret dependencies) that should be propagated to the same clusters as the
+	// resource template it was run against.
+	InterpreterOperationGetFollowers InterpreterOperation = "GetFollowers"
+)
@@ -0,0 +1,246 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Follower declares that the namespaced resource template it's named after should be propagated to every
+// cluster its leaders are propagated to, without needing a PropagationPolicy of its own. This lets a
+// namespace-scoped dependency (e.g. a ConfigMap) trail a Deployment across clusters even when the two are
+// matched by unrelated policies, or no policy at all.
+type Follower struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec represents the desired behavior of Follower.
+	Spec FollowerSpec `json:"spec"`
+}
+
+// FollowerSpec represents the desired behavior of Follower/ClusterFollower.
+type FollowerSpec struct {
+	// Leaders are the resource templates this object should follow. The object is propagated to the union of
+	// clusters its leaders are propagated to, and removed from a cluster once none of its leaders are there
+	// anymore.
+	// +kubebuilder:validation:MinItems=1
+	Leaders []FollowerLeaderReference `json:"leaders"`
+}
+
+// FollowerLeaderReference identifies a resource template a Follower/ClusterFollower trails.
+type FollowerLeaderReference struct {
+	// APIVersion represents the API version of the leader.
+	APIVersion string `json:"apiVersion"`
+	// Kind represents the kind of the leader.
+	Kind string `json:"kind"`
+	// Namespace represents the namespace of the leader. Empty for a cluster-scoped leader.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name represents the name of the leader.
+	Name string `json:"name"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FollowerList contains a list of Follower.
+type FollowerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Follower `json:"items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterFollower is the cluster-scoped counterpart of Follower, declaring that the cluster-scoped resource
+// template it's named after should be propagated alongside its leaders.
+type ClusterFollower struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec represents the desired behavior of ClusterFollower.
+	Spec FollowerSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterFollowerList contains a list of ClusterFollower.
+type ClusterFollowerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterFollower `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Follower{}, &FollowerList{}, &ClusterFollower{}, &ClusterFollowerList{})
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FollowerLeaderReference) DeepCopyInto(out *FollowerLeaderReference) {
+	*out = *in
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new FollowerLeaderReference.
+func (in *FollowerLeaderReference) DeepCopy() *FollowerLeaderReference {
+	if in == nil {
+		return nil
+	}
+	out := new(FollowerLeaderReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FollowerSpec) DeepCopyInto(out *FollowerSpec) {
+	*out = *in
+	if in.Leaders != nil {
+		inItems, outItems := &in.Leaders, &out.Leaders
+		*outItems = make([]FollowerLeaderReference, len(*inItems))
+		copy(*outItems, *inItems)
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new FollowerSpec.
+func (in *FollowerSpec) DeepCopy() *FollowerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FollowerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Follower) DeepCopyInto(out *Follower) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new Follower.
+func (in *Follower) DeepCopy() *Follower {
+	if in == nil {
+		return nil
+	}
+	out := new(Follower)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Follower) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FollowerList) DeepCopyInto(out *FollowerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		inItems, outItems := &in.Items, &out.Items
+		*outItems = make([]Follower, len(*inItems))
+		for i := range *inItems {
+			(*inItems)[i].DeepCopyInto(&(*outItems)[i])
+		}
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new FollowerList.
+func (in *FollowerList) DeepCopy() *FollowerList {
+	if in == nil {
+		return nil
+	}
+	out := new(FollowerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FollowerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterFollower) DeepCopyInto(out *ClusterFollower) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ClusterFollower.
+func (in *ClusterFollower) DeepCopy() *ClusterFollower {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterFollower)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterFollower) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterFollowerList) DeepCopyInto(out *ClusterFollowerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		inItems, outItems := &in.Items, &out.Items
+		*outItems = make([]ClusterFollower, len(*inItems))
+		for i := range *inItems {
+			(*inItems)[i].DeepCopyInto(&(*outItems)[i])
+		}
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ClusterFollowerList.
+func (in *ClusterFollowerList) DeepCopy() *ClusterFollowerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterFollowerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterFollowerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
@@ -0,0 +1,343 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+const (
+	// PropagationPolicyPermanentIDLabel is the label, carried by both a PropagationPolicy and whatever it
+	// matches, used to look a policy's claims back up without re-running selection. It survives the policy's
+	// name/namespace changing, unlike a plain name-based label would.
+	PropagationPolicyPermanentIDLabel = "propagationpolicy.karmada.io/permanent-id"
+	// ClusterPropagationPolicyPermanentIDLabel is the ClusterPropagationPolicy counterpart of
+	// PropagationPolicyPermanentIDLabel.
+	ClusterPropagationPolicyPermanentIDLabel = "clusterpropagationpolicy.karmada.io/permanent-id"
+
+	// PropagationPolicyNamespaceAnnotation records the namespace of the PropagationPolicy that claimed a
+	// resource template, so the claim can be traced back to a concrete object even across renames.
+	PropagationPolicyNamespaceAnnotation = "propagationpolicy.karmada.io/namespace"
+	// PropagationPolicyNameAnnotation records the name of the PropagationPolicy that claimed a resource
+	// template.
+	PropagationPolicyNameAnnotation = "propagationpolicy.karmada.io/name"
+	// ClusterPropagationPolicyAnnotation records the name of the ClusterPropagationPolicy that claimed a
+	// resource template.
+	ClusterPropagationPolicyAnnotation = "clusterpropagationpolicy.karmada.io/name"
+
+	// ResourcePluralPropagationPolicy is the plural name of PropagationPolicy, used to build its
+	// GroupVersionResource for informer registration.
+	ResourcePluralPropagationPolicy = "propagationpolicies"
+	// ResourcePluralClusterPropagationPolicy is the plural name of ClusterPropagationPolicy.
+	ResourcePluralClusterPropagationPolicy = "clusterpropagationpolicies"
+)
+
+// ActivationPreference indicates how the referencing resource template should be synced to the bound
+// ResourceBinding/ClusterResourceBinding.
+type ActivationPreference string
+
+// LazyActivation means, after the resource template has been changed, the controller will not immediately
+// sync it to the bound ResourceBinding/ClusterResourceBinding, and the sync will happen when the resource is
+// about to be scheduled.
+const LazyActivation ActivationPreference = "Lazy"
+
+// PreemptionBehavior describes whether and how a PropagationPolicy/ClusterPropagationPolicy can preempt
+// resource templates claimed by another policy with a lower priority.
+type PreemptionBehavior string
+
+const (
+	// PreemptAlways means the policy can preempt any resource template claimed by a lower-priority policy.
+	PreemptAlways PreemptionBehavior = "Always"
+	// PreemptNever means the policy never preempts, regardless of priority.
+	PreemptNever PreemptionBehavior = "Never"
+)
+
+// ResourceSelector specifies the resources to be selected by a PropagationPolicy/ClusterPropagationPolicy.
+type ResourceSelector struct {
+	// APIVersion represents the API version of the target resource.
+	APIVersion string `json:"apiVersion"`
+	// Kind represents the Kind of the target resource.
+	Kind string `json:"kind"`
+	// Namespace of the target resource. Default is empty, which means inherit from the parent object scope.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the target resource. Default is empty, which means selecting all resources.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// LabelSelector is a filter to select resources by a label.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// PropagationSpec represents the desired behavior of PropagationPolicy/ClusterPropagationPolicy.
+type PropagationSpec struct {
+	// ResourceSelectors used to select resources.
+	// +optional
+	ResourceSelectors []ResourceSelector `json:"resourceSelectors,omitempty"`
+
+	// Placement represents the rule for select clusters to propagate resources.
+	// +optional
+	Placement workv1alpha2.Placement `json:"placement,omitempty"`
+
+	// PropagateDeps tells if the dependencies of the referencing resource should be propagated automatically.
+	// +optional
+	PropagateDeps bool `json:"propagateDeps,omitempty"`
+
+	// SchedulerName represents which scheduler to proceed the scheduling if specified.
+	// +optional
+	SchedulerName string `json:"schedulerName,omitempty"`
+
+	// Failover indicates how Karmada deals with the failure of an application.
+	// +optional
+	Failover *workv1alpha2.FailoverBehavior `json:"failover,omitempty"`
+
+	// ConflictResolution declares how potential conflicts should be handled when resource already exists
+	// in the target cluster.
+	// +optional
+	ConflictResolution workv1alpha2.ConflictResolution `json:"conflictResolution,omitempty"`
+
+	// Preemption declares the behaviors for the policy to preempt resource templates claimed by a
+	// lower-priority PropagationPolicy/ClusterPropagationPolicy.
+	// +optional
+	Preemption PreemptionBehavior `json:"preemption,omitempty"`
+
+	// ActivationPreference indicates how the referencing resource template should be synced to the bound
+	// ResourceBinding/ClusterResourceBinding.
+	// +optional
+	ActivationPreference ActivationPreference `json:"activationPreference,omitempty"`
+
+	// Followers declares the other resource templates that should be propagated to the same clusters as the
+	// resource templates this policy selects, merged with whatever the ResourceInterpreter's GetFollowers
+	// hook discovers on its own.
+	// +optional
+	Followers []workv1alpha2.ObjectReference `json:"followers,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PropagationPolicy represents the policy that propagates a group of resources to one or more clusters.
+type PropagationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec represents the desired behavior of PropagationPolicy.
+	Spec PropagationSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PropagationPolicyList contains a list of PropagationPolicy.
+type PropagationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PropagationPolicy `json:"items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterPropagationPolicy is the cluster-scoped counterpart of PropagationPolicy.
+type ClusterPropagationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec represents the desired behavior of ClusterPropagationPolicy.
+	Spec PropagationSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterPropagationPolicyList contains a list of ClusterPropagationPolicy.
+type ClusterPropagationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterPropagationPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PropagationPolicy{}, &PropagationPolicyList{}, &ClusterPropagationPolicy{}, &ClusterPropagationPolicyList{})
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceSelector) DeepCopyInto(out *ResourceSelector) {
+	*out = *in
+	if in.LabelSelector != nil {
+		out.LabelSelector = in.LabelSelector.DeepCopy()
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ResourceSelector.
+func (in *ResourceSelector) DeepCopy() *ResourceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropagationSpec) DeepCopyInto(out *PropagationSpec) {
+	*out = *in
+	if in.ResourceSelectors != nil {
+		inItems, outItems := &in.ResourceSelectors, &out.ResourceSelectors
+		*outItems = make([]ResourceSelector, len(*inItems))
+		for i := range *inItems {
+			(*inItems)[i].DeepCopyInto(&(*outItems)[i])
+		}
+	}
+	in.Placement.DeepCopyInto(&out.Placement)
+	if in.Failover != nil {
+		out.Failover = in.Failover.DeepCopy()
+	}
+	if in.Followers != nil {
+		inItems, outItems := &in.Followers, &out.Followers
+		*outItems = make([]workv1alpha2.ObjectReference, len(*inItems))
+		copy(*outItems, *inItems)
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new PropagationSpec.
+func (in *PropagationSpec) DeepCopy() *PropagationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PropagationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropagationPolicy) DeepCopyInto(out *PropagationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new PropagationPolicy.
+func (in *PropagationPolicy) DeepCopy() *PropagationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PropagationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PropagationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropagationPolicyList) DeepCopyInto(out *PropagationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		inItems, outItems := &in.Items, &out.Items
+		*outItems = make([]PropagationPolicy, len(*inItems))
+		for i := range *inItems {
+			(*inItems)[i].DeepCopyInto(&(*outItems)[i])
+		}
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new PropagationPolicyList.
+func (in *PropagationPolicyList) DeepCopy() *PropagationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PropagationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PropagationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPropagationPolicy) DeepCopyInto(out *ClusterPropagationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ClusterPropagationPolicy.
+func (in *ClusterPropagationPolicy) DeepCopy() *ClusterPropagationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPropagationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterPropagationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPropagationPolicyList) DeepCopyInto(out *ClusterPropagationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		inItems, outItems := &in.Items, &out.Items
+		*outItems = make([]ClusterPropagationPolicy, len(*inItems))
+		for i := range *inItems {
+			(*inItems)[i].DeepCopyInto(&(*outItems)[i])
+		}
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ClusterPropagationPolicyList.
+func (in *ClusterPropagationPolicyList) DeepCopy() *ClusterPropagationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPropagationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterPropagationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
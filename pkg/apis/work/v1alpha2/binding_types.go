@@ -0,0 +1,461 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ConflictResolution describes how to handle the conflict when resource already exists in the target cluster.
+type ConflictResolution string
+
+const (
+	// ConflictOverwrite means that resolves the conflict by overwriting the resource with the propagating resource template.
+	ConflictOverwrite ConflictResolution = "Overwrite"
+	// ConflictAbort means that do not resolve the conflict and stop propagating.
+	ConflictAbort ConflictResolution = "Abort"
+)
+
+// Placement represents the rule for select clusters to propagate resources, shared by
+// PropagationPolicy/ClusterPropagationPolicy and the ResourceBinding/ClusterResourceBinding resolved from them.
+type Placement struct {
+	// ClusterAffinity represents scheduling restrictions to a certain set of clusters.
+	// +optional
+	ClusterAffinity *ClusterAffinity `json:"clusterAffinity,omitempty"`
+}
+
+// ClusterAffinity represents the filter to select clusters.
+type ClusterAffinity struct {
+	// ClusterNames is the list of clusters to be selected.
+	// +optional
+	ClusterNames []string `json:"clusterNames,omitempty"`
+}
+
+// FailoverBehavior indicates failover behaviors in case of an application or cluster failure.
+type FailoverBehavior struct {
+	// GracePeriodSeconds is the maximum time in seconds to wait for the resource to recover on a failed
+	// cluster before migrating it to another one.
+	// +optional
+	GracePeriodSeconds *int32 `json:"gracePeriodSeconds,omitempty"`
+}
+
+// ObjectReference identifies a resource template, either as the thing a ResourceBinding/FederatedObject was
+// built from or as a follower/override target, without needing to keep a live client handle to it.
+type ObjectReference struct {
+	// APIVersion represents the API version of the target resource.
+	APIVersion string `json:"apiVersion,omitempty"`
+	// Kind represents the Kind of the target resource.
+	Kind string `json:"kind,omitempty"`
+	// Namespace represents the namespace of the target resource. Empty for a cluster-scoped resource.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name represents the name of the target resource.
+	Name string `json:"name,omitempty"`
+	// UID of the target resource, populated when it's known at the time the reference is taken.
+	// +optional
+	UID types.UID `json:"uid,omitempty"`
+	// ResourceVersion of the target resource, populated when it's known at the time the reference is taken.
+	// +optional
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+// ReplicaRequirements represents the requirements required by each replica of the referencing resource.
+type ReplicaRequirements struct {
+	// ResourceRequest represents the resources required by each replica.
+	// +optional
+	ResourceRequest corev1.ResourceList `json:"resourceRequest,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ResourceBinding represents a binding of a kubernetes resource with a propagation policy.
+type ResourceBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec represents the desired behavior of ResourceBinding.
+	Spec ResourceBindingSpec `json:"spec"`
+
+	// Status represents the most recently observed status of the ResourceBinding.
+	// +optional
+	Status ResourceBindingStatus `json:"status,omitempty"`
+}
+
+// ResourceBindingSpec represents the desired behavior of ResourceBinding/ClusterResourceBinding.
+type ResourceBindingSpec struct {
+	// Resource represents the resource template to be propagated.
+	Resource ObjectReference `json:"resource"`
+
+	// Replicas represents the replica number of the referencing resource, as customized by the
+	// ResourceInterpreter's InterpretReplica hook.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// ReplicaRequirements represents the requirements required by each replica, as customized by the
+	// ResourceInterpreter's InterpretReplica hook.
+	// +optional
+	ReplicaRequirements *ReplicaRequirements `json:"replicaRequirements,omitempty"`
+
+	// PropagateDeps tells if the dependencies of the referencing resource should be propagated automatically.
+	// +optional
+	PropagateDeps bool `json:"propagateDeps,omitempty"`
+
+	// SchedulerName represents the scheduler which will be used to schedule this binding, inherited from the
+	// matched PropagationPolicy/ClusterPropagationPolicy.
+	// +optional
+	SchedulerName string `json:"schedulerName,omitempty"`
+
+	// Placement represents the rule for select clusters to propagate resources, resolved from the matched
+	// PropagationPolicy/ClusterPropagationPolicy at the time the binding was last built.
+	// +optional
+	Placement *Placement `json:"placement,omitempty"`
+
+	// Failover indicates how Karmada deals with the failure of an application, inherited from the matched
+	// PropagationPolicy/ClusterPropagationPolicy.
+	// +optional
+	Failover *FailoverBehavior `json:"failover,omitempty"`
+
+	// ConflictResolution declares how potential conflicts should be handled when ResourceBinding's
+	// target resource already exists in the target cluster.
+	// +optional
+	ConflictResolution ConflictResolution `json:"conflictResolution,omitempty"`
+
+	// Followers are the resource templates that should be propagated to the same clusters as this binding,
+	// merged from the ResourceInterpreter's GetFollowers hook and the policy's declared followers.
+	// +optional
+	Followers []ObjectReference `json:"followers,omitempty"`
+
+	// Template is a verbatim snapshot of the resource template, captured when the EmbeddedTemplateBinding
+	// feature is enabled so the binding is sufficient on its own to re-derive what was scheduled, without
+	// re-reading the source cluster's informer cache.
+	// +optional
+	Template *runtime.RawExtension `json:"template,omitempty"`
+}
+
+// ResourceBindingStatus represents the most recently observed status of ResourceBinding/ClusterResourceBinding.
+type ResourceBindingStatus struct {
+	// Conditions contain the different condition statuses for this binding.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ResourceBindingList contains a list of ResourceBinding.
+type ResourceBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceBinding `json:"items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterResourceBinding is the cluster-scoped counterpart of ResourceBinding.
+type ClusterResourceBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec represents the desired behavior of ClusterResourceBinding.
+	Spec ResourceBindingSpec `json:"spec"`
+
+	// Status represents the most recently observed status of the ClusterResourceBinding.
+	// +optional
+	Status ResourceBindingStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterResourceBindingList contains a list of ClusterResourceBinding.
+type ClusterResourceBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterResourceBinding `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ResourceBinding{}, &ResourceBindingList{}, &ClusterResourceBinding{}, &ClusterResourceBindingList{})
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAffinity) DeepCopyInto(out *ClusterAffinity) {
+	*out = *in
+	if in.ClusterNames != nil {
+		inItems, outItems := &in.ClusterNames, &out.ClusterNames
+		*outItems = make([]string, len(*inItems))
+		copy(*outItems, *inItems)
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ClusterAffinity.
+func (in *ClusterAffinity) DeepCopy() *ClusterAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Placement) DeepCopyInto(out *Placement) {
+	*out = *in
+	if in.ClusterAffinity != nil {
+		out.ClusterAffinity = in.ClusterAffinity.DeepCopy()
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new Placement.
+func (in *Placement) DeepCopy() *Placement {
+	if in == nil {
+		return nil
+	}
+	out := new(Placement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverBehavior) DeepCopyInto(out *FailoverBehavior) {
+	*out = *in
+	if in.GracePeriodSeconds != nil {
+		out.GracePeriodSeconds = new(int32)
+		*out.GracePeriodSeconds = *in.GracePeriodSeconds
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new FailoverBehavior.
+func (in *FailoverBehavior) DeepCopy() *FailoverBehavior {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverBehavior)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectReference) DeepCopyInto(out *ObjectReference) {
+	*out = *in
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ObjectReference.
+func (in *ObjectReference) DeepCopy() *ObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaRequirements) DeepCopyInto(out *ReplicaRequirements) {
+	*out = *in
+	if in.ResourceRequest != nil {
+		out.ResourceRequest = in.ResourceRequest.DeepCopy()
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ReplicaRequirements.
+func (in *ReplicaRequirements) DeepCopy() *ReplicaRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceBindingSpec) DeepCopyInto(out *ResourceBindingSpec) {
+	*out = *in
+	out.Resource = in.Resource
+	if in.ReplicaRequirements != nil {
+		out.ReplicaRequirements = in.ReplicaRequirements.DeepCopy()
+	}
+	if in.Placement != nil {
+		out.Placement = in.Placement.DeepCopy()
+	}
+	if in.Failover != nil {
+		out.Failover = in.Failover.DeepCopy()
+	}
+	if in.Followers != nil {
+		inItems, outItems := &in.Followers, &out.Followers
+		*outItems = make([]ObjectReference, len(*inItems))
+		copy(*outItems, *inItems)
+	}
+	if in.Template != nil {
+		out.Template = new(runtime.RawExtension)
+		in.Template.DeepCopyInto(out.Template)
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ResourceBindingSpec.
+func (in *ResourceBindingSpec) DeepCopy() *ResourceBindingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceBindingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceBindingStatus) DeepCopyInto(out *ResourceBindingStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		inItems, outItems := &in.Conditions, &out.Conditions
+		*outItems = make([]metav1.Condition, len(*inItems))
+		for i := range *inItems {
+			(*inItems)[i].DeepCopyInto(&(*outItems)[i])
+		}
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ResourceBindingStatus.
+func (in *ResourceBindingStatus) DeepCopy() *ResourceBindingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceBindingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceBinding) DeepCopyInto(out *ResourceBinding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ResourceBinding.
+func (in *ResourceBinding) DeepCopy() *ResourceBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceBindingList) DeepCopyInto(out *ResourceBindingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		inItems, outItems := &in.Items, &out.Items
+		*outItems = make([]ResourceBinding, len(*inItems))
+		for i := range *inItems {
+			(*inItems)[i].DeepCopyInto(&(*outItems)[i])
+		}
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ResourceBindingList.
+func (in *ResourceBindingList) DeepCopy() *ResourceBindingList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceBindingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceBinding) DeepCopyInto(out *ClusterResourceBinding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ClusterResourceBinding.
+func (in *ClusterResourceBinding) DeepCopy() *ClusterResourceBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterResourceBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceBindingList) DeepCopyInto(out *ClusterResourceBindingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		inItems, outItems := &in.Items, &out.Items
+		*outItems = make([]ClusterResourceBinding, len(*inItems))
+		for i := range *inItems {
+			(*inItems)[i].DeepCopyInto(&(*outItems)[i])
+		}
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ClusterResourceBindingList.
+func (in *ClusterResourceBindingList) DeepCopy() *ClusterResourceBindingList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceBindingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterResourceBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
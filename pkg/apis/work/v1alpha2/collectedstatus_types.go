@@ -0,0 +1,251 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CollectedStatus holds the per-cluster status collected for the resource template a ResourceBinding of the
+// same namespace/name propagates, split out of ResourceBinding.Status so that status writers no longer
+// contend with the scheduler and other spec writers on the binding itself.
+type CollectedStatus struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Status represents the status of the resource template propagated to member clusters.
+	// +optional
+	Status CollectedStatusStatus `json:"status,omitempty"`
+}
+
+// CollectedStatusStatus contains the status collected from member clusters for a resource template.
+type CollectedStatusStatus struct {
+	// AggregatedStatus represents status list of the resource running in each member cluster.
+	// +optional
+	AggregatedStatus []AggregatedStatusItem `json:"aggregatedStatus,omitempty"`
+}
+
+// AggregatedStatusItem represents the resource running status on a member cluster.
+type AggregatedStatusItem struct {
+	// ClusterName is the name of member cluster.
+	ClusterName string `json:"clusterName"`
+	// Status reflects the running status of the current cluster.
+	// +optional
+	Status *runtime.RawExtension `json:"status,omitempty"`
+	// Applied represents if the resource template has been successfully applied to the cluster.
+	// +optional
+	Applied bool `json:"applied,omitempty"`
+	// AppliedMessage gives the detailed information of the applied failure.
+	// +optional
+	AppliedMessage string `json:"appliedMessage,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CollectedStatusList contains a list of CollectedStatus.
+type CollectedStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CollectedStatus `json:"items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterCollectedStatus is the cluster-scoped counterpart of CollectedStatus, holding the per-cluster status
+// collected for the resource template a ClusterResourceBinding of the same name propagates.
+type ClusterCollectedStatus struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Status CollectedStatusStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterCollectedStatusList contains a list of ClusterCollectedStatus.
+type ClusterCollectedStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterCollectedStatus `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CollectedStatus{}, &CollectedStatusList{}, &ClusterCollectedStatus{}, &ClusterCollectedStatusList{})
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AggregatedStatusItem) DeepCopyInto(out *AggregatedStatusItem) {
+	*out = *in
+	if in.Status != nil {
+		out.Status = in.Status.DeepCopy()
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new AggregatedStatusItem.
+func (in *AggregatedStatusItem) DeepCopy() *AggregatedStatusItem {
+	if in == nil {
+		return nil
+	}
+	out := new(AggregatedStatusItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectedStatusStatus) DeepCopyInto(out *CollectedStatusStatus) {
+	*out = *in
+	if in.AggregatedStatus != nil {
+		inItems, outItems := &in.AggregatedStatus, &out.AggregatedStatus
+		*outItems = make([]AggregatedStatusItem, len(*inItems))
+		for i := range *inItems {
+			(*inItems)[i].DeepCopyInto(&(*outItems)[i])
+		}
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new CollectedStatusStatus.
+func (in *CollectedStatusStatus) DeepCopy() *CollectedStatusStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectedStatusStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectedStatus) DeepCopyInto(out *CollectedStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new CollectedStatus.
+func (in *CollectedStatus) DeepCopy() *CollectedStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectedStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CollectedStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectedStatusList) DeepCopyInto(out *CollectedStatusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		inItems, outItems := &in.Items, &out.Items
+		*outItems = make([]CollectedStatus, len(*inItems))
+		for i := range *inItems {
+			(*inItems)[i].DeepCopyInto(&(*outItems)[i])
+		}
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new CollectedStatusList.
+func (in *CollectedStatusList) DeepCopy() *CollectedStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectedStatusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CollectedStatusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCollectedStatus) DeepCopyInto(out *ClusterCollectedStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ClusterCollectedStatus.
+func (in *ClusterCollectedStatus) DeepCopy() *ClusterCollectedStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCollectedStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterCollectedStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCollectedStatusList) DeepCopyInto(out *ClusterCollectedStatusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		inItems, outItems := &in.Items, &out.Items
+		*outItems = make([]ClusterCollectedStatus, len(*inItems))
+		for i := range *inItems {
+			(*inItems)[i].DeepCopyInto(&(*outItems)[i])
+		}
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ClusterCollectedStatusList.
+func (in *ClusterCollectedStatusList) DeepCopy() *ClusterCollectedStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCollectedStatusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterCollectedStatusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PolicyBoundConditionType indicates whether a resource template is currently bound to a matched
+// PropagationPolicy/ClusterPropagationPolicy. Its Reason distinguishes how the binding came to be in that
+// state (the detector sets PolicyMatched or PolicyReleased), and LastTransitionTime only advances when the
+// condition's Status actually changes, so it can be used to debug rebinding churn and stuck cleanup loops via
+// `kubectl get rb -o yaml` without reading controller logs.
+const PolicyBoundConditionType = "PolicyBound"
+
+// Reasons for the PolicyBound condition.
+const (
+	// PolicyMatchedReason is set each time a PropagationPolicy/ClusterPropagationPolicy that claims the binding
+	// is applied to it, including when a higher-priority policy has taken the binding over by preemption.
+	PolicyMatchedReason = "PolicyMatched"
+	// PolicyPreemptedReason names the case where a higher-priority policy preempts the one that previously
+	// claimed the binding. The detector does not set it: a preempted binding gets PolicyMatchedReason from the
+	// preempting policy.
+	PolicyPreemptedReason = "PolicyPreempted"
+	// PolicyReleasedReason is set when the policy that claimed the binding is deleted.
+	PolicyReleasedReason = "PolicyReleased"
+)
+
+// SetCondition sets the PolicyBound condition (or any other condition) on ResourceBinding.Status.Conditions,
+// only bumping LastTransitionTime when Status actually changes.
+func (rb *ResourceBinding) SetCondition(condition metav1.Condition) {
+	meta.SetStatusCondition(&rb.Status.Conditions, condition)
+}
+
+// RemoveCondition removes a condition of conditionType from ResourceBinding.Status.Conditions, if present.
+func (rb *ResourceBinding) RemoveCondition(conditionType string) {
+	meta.RemoveStatusCondition(&rb.Status.Conditions, conditionType)
+}
+
+// SetCondition sets the PolicyBound condition (or any other condition) on ClusterResourceBinding.Status.Conditions,
+// only bumping LastTransitionTime when Status actually changes.
+func (crb *ClusterResourceBinding) SetCondition(condition metav1.Condition) {
+	meta.SetStatusCondition(&crb.Status.Conditions, condition)
+}
+
+// RemoveCondition removes a condition of conditionType from ClusterResourceBinding.Status.Conditions, if present.
+func (crb *ClusterResourceBinding) RemoveCondition(conditionType string) {
+	meta.RemoveStatusCondition(&crb.Status.Conditions, conditionType)
+}
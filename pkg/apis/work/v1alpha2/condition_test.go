@@ -0,0 +1,121 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestResourceBindingSetConditionPreservesTransitionTimeWhenUnchanged(t *testing.T) {
+	rb := &ResourceBinding{}
+	firstTransition := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	rb.SetCondition(metav1.Condition{
+		Type:               PolicyBoundConditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             PolicyMatchedReason,
+		Message:            "bound",
+		LastTransitionTime: firstTransition,
+	})
+
+	// Re-setting the same Status should not bump LastTransitionTime, only Reason/Message.
+	rb.SetCondition(metav1.Condition{
+		Type:    PolicyBoundConditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  PolicyMatchedReason,
+		Message: "still bound",
+	})
+
+	got := rb.Status.Conditions[0]
+	if !got.LastTransitionTime.Equal(&firstTransition) {
+		t.Errorf("expected LastTransitionTime to stay at %v when Status is unchanged, got %v", firstTransition, got.LastTransitionTime)
+	}
+	if got.Message != "still bound" {
+		t.Errorf("expected Message to be updated to %q, got %q", "still bound", got.Message)
+	}
+}
+
+func TestResourceBindingSetConditionBumpsTransitionTimeOnStatusChange(t *testing.T) {
+	rb := &ResourceBinding{}
+	firstTransition := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	rb.SetCondition(metav1.Condition{
+		Type:               PolicyBoundConditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             PolicyMatchedReason,
+		LastTransitionTime: firstTransition,
+	})
+
+	rb.SetCondition(metav1.Condition{
+		Type:   PolicyBoundConditionType,
+		Status: metav1.ConditionFalse,
+		Reason: PolicyReleasedReason,
+	})
+
+	got := rb.Status.Conditions[0]
+	if got.LastTransitionTime.Equal(&firstTransition) {
+		t.Errorf("expected LastTransitionTime to advance past %v when Status changes, got %v", firstTransition, got.LastTransitionTime)
+	}
+	if got.Reason != PolicyReleasedReason {
+		t.Errorf("expected Reason to be updated to %q, got %q", PolicyReleasedReason, got.Reason)
+	}
+}
+
+func TestResourceBindingRemoveCondition(t *testing.T) {
+	rb := &ResourceBinding{}
+	rb.SetCondition(metav1.Condition{
+		Type:   PolicyBoundConditionType,
+		Status: metav1.ConditionTrue,
+		Reason: PolicyMatchedReason,
+	})
+
+	rb.RemoveCondition(PolicyBoundConditionType)
+
+	if len(rb.Status.Conditions) != 0 {
+		t.Errorf("expected RemoveCondition to remove the condition, got %v", rb.Status.Conditions)
+	}
+}
+
+func TestClusterResourceBindingSetConditionBumpsTransitionTimeOnStatusChange(t *testing.T) {
+	crb := &ClusterResourceBinding{}
+	firstTransition := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	crb.SetCondition(metav1.Condition{
+		Type:               PolicyBoundConditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             PolicyMatchedReason,
+		LastTransitionTime: firstTransition,
+	})
+	crb.SetCondition(metav1.Condition{
+		Type:   PolicyBoundConditionType,
+		Status: metav1.ConditionFalse,
+		Reason: PolicyReleasedReason,
+	})
+
+	got := crb.Status.Conditions[0]
+	if got.LastTransitionTime.Equal(&firstTransition) {
+		t.Errorf("expected LastTransitionTime to advance past %v when Status changes, got %v", firstTransition, got.LastTransitionTime)
+	}
+
+	crb.RemoveCondition(PolicyBoundConditionType)
+	if len(crb.Status.Conditions) != 0 {
+		t.Errorf("expected RemoveCondition to remove the condition, got %v", crb.Status.Conditions)
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FederatedObject carries a resource template verbatim alongside its resolved placement, as an alternative to
+// ResourceBinding for GVKs the ResourceInterpreter has no rules for: since there's nothing to interpret,
+// there's nothing a binding's replica/affinity bookkeeping would add.
+type FederatedObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec represents the desired behavior of FederatedObject.
+	Spec FederatedObjectSpec `json:"spec"`
+}
+
+// FederatedObjectSpec represents the desired behavior of FederatedObject/ClusterFederatedObject.
+type FederatedObjectSpec struct {
+	// Template is the raw resource template to be propagated to member clusters, stripped of server-side
+	// and status fields that shouldn't be replayed.
+	Template runtime.RawExtension `json:"template"`
+	// Placement represents the rule for selecting clusters to propagate resources to, resolved from the
+	// matched PropagationPolicy/ClusterPropagationPolicy at the time the template was last propagated.
+	// +optional
+	Placement *Placement `json:"placement,omitempty"`
+	// Followers are the resource templates that should be propagated to the same clusters as this one.
+	// +optional
+	Followers []ObjectReference `json:"followers,omitempty"`
+	// Overrides captures the override rules applied to the template, if any. Reserved for when override
+	// resolution is tracked independently of ResourceBinding; unset for now.
+	// +optional
+	Overrides []ObjectReference `json:"overrides,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FederatedObjectList contains a list of FederatedObject.
+type FederatedObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FederatedObject `json:"items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterFederatedObject is the cluster-scoped counterpart of FederatedObject.
+type ClusterFederatedObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec represents the desired behavior of ClusterFederatedObject.
+	Spec FederatedObjectSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterFederatedObjectList contains a list of ClusterFederatedObject.
+type ClusterFederatedObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterFederatedObject `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FederatedObject{}, &FederatedObjectList{}, &ClusterFederatedObject{}, &ClusterFederatedObjectList{})
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederatedObjectSpec) DeepCopyInto(out *FederatedObjectSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Placement != nil {
+		out.Placement = in.Placement.DeepCopy()
+	}
+	if in.Followers != nil {
+		inItems, outItems := &in.Followers, &out.Followers
+		*outItems = make([]ObjectReference, len(*inItems))
+		copy(*outItems, *inItems)
+	}
+	if in.Overrides != nil {
+		inItems, outItems := &in.Overrides, &out.Overrides
+		*outItems = make([]ObjectReference, len(*inItems))
+		copy(*outItems, *inItems)
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new FederatedObjectSpec.
+func (in *FederatedObjectSpec) DeepCopy() *FederatedObjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FederatedObjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederatedObject) DeepCopyInto(out *FederatedObject) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new FederatedObject.
+func (in *FederatedObject) DeepCopy() *FederatedObject {
+	if in == nil {
+		return nil
+	}
+	out := new(FederatedObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FederatedObject) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederatedObjectList) DeepCopyInto(out *FederatedObjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		inItems, outItems := &in.Items, &out.Items
+		*outItems = make([]FederatedObject, len(*inItems))
+		for i := range *inItems {
+			(*inItems)[i].DeepCopyInto(&(*outItems)[i])
+		}
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new FederatedObjectList.
+func (in *FederatedObjectList) DeepCopy() *FederatedObjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(FederatedObjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FederatedObjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterFederatedObject) DeepCopyInto(out *ClusterFederatedObject) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ClusterFederatedObject.
+func (in *ClusterFederatedObject) DeepCopy() *ClusterFederatedObject {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterFederatedObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterFederatedObject) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterFederatedObjectList) DeepCopyInto(out *ClusterFederatedObjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		inItems, outItems := &in.Items, &out.Items
+		*outItems = make([]ClusterFederatedObject, len(*inItems))
+		for i := range *inItems {
+			(*inItems)[i].DeepCopyInto(&(*outItems)[i])
+		}
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ClusterFederatedObjectList.
+func (in *ClusterFederatedObjectList) DeepCopy() *ClusterFederatedObjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterFederatedObjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterFederatedObjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
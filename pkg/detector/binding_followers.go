@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package detector
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+)
+
+// resolveFollowers merges the followers the ResourceInterpreter discovers for object (InterpreterOperationGetFollowers)
+// with the followers the policy declares directly (spec.followers), deduplicating by GVK+namespace+name.
+// A policy-declared follower that doesn't currently exist in the cluster is recorded in the waiting list with a
+// "waiting on follower" reason, so HandlePropagationPolicyCreationOrUpdate can pick it up once it shows up.
+func (d *ResourceDetector) resolveFollowers(object *unstructured.Unstructured, policySpec *policyv1alpha1.PropagationSpec) ([]workv1alpha2.ObjectReference, error) {
+	var followers []workv1alpha2.ObjectReference
+
+	if d.ResourceInterpreter.HookEnabled(object.GroupVersionKind(), configv1alpha1.InterpreterOperationGetFollowers) {
+		interpreted, err := d.ResourceInterpreter.GetFollowers(object)
+		if err != nil {
+			klog.Errorf("Failed to get followers for %s(%s/%s): %v", object.GroupVersionKind(), object.GetNamespace(), object.GetName(), err)
+			return nil, err
+		}
+		followers = append(followers, interpreted...)
+	}
+
+	for _, declared := range policySpec.Followers {
+		followerKey, err := helper.ConstructClusterWideKey(declared)
+		if err != nil {
+			klog.Errorf("Failed to build key for declared follower(%s/%s/%s): %v", declared.APIVersion, declared.Kind, declared.Name, err)
+			continue
+		}
+
+		found := false
+		for _, existing := range followers {
+			if existing.APIVersion == declared.APIVersion && existing.Kind == declared.Kind &&
+				existing.Namespace == declared.Namespace && existing.Name == declared.Name {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+
+		if _, err := d.GetUnstructuredObject(followerKey); err != nil {
+			klog.Infof("Declared follower(%s) of object(%s/%s) not found yet, waiting.", followerKey, object.GetNamespace(), object.GetName())
+			d.AddWaitingWithReason(followerKey, fmt.Sprintf("waiting on follower %s", followerKey))
+			continue
+		}
+
+		followers = append(followers, declared)
+	}
+
+	return followers, nil
+}
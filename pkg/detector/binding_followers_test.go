@@ -0,0 +1,124 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package detector
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util/fedinformer/keys"
+)
+
+// fakeResourceInterpreter is a minimal resourceinterpreter.ResourceInterpreter stub for exercising
+// resolveFollowers without pulling in the real built-in/Lua/webhook interpreters.
+type fakeResourceInterpreter struct {
+	followersEnabled bool
+	followers        []workv1alpha2.ObjectReference
+	followersErr     error
+}
+
+func (f *fakeResourceInterpreter) HookEnabled(_ schema.GroupVersionKind, operation configv1alpha1.InterpreterOperation) bool {
+	return operation == configv1alpha1.InterpreterOperationGetFollowers && f.followersEnabled
+}
+
+func (f *fakeResourceInterpreter) GetReplicas(_ *unstructured.Unstructured) (int32, *workv1alpha2.ReplicaRequirements, error) {
+	return 0, nil, nil
+}
+
+func (f *fakeResourceInterpreter) GetFollowers(_ *unstructured.Unstructured) ([]workv1alpha2.ObjectReference, error) {
+	return f.followers, f.followersErr
+}
+
+func TestResolveFollowersDedupesDeclaredAgainstInterpreted(t *testing.T) {
+	interpreted := workv1alpha2.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Namespace:  "default",
+		Name:       "shared-config",
+	}
+
+	d := &ResourceDetector{
+		ResourceInterpreter: &fakeResourceInterpreter{
+			followersEnabled: true,
+			followers:        []workv1alpha2.ObjectReference{interpreted},
+		},
+		waitingObjects: make(map[keys.ClusterWideKey]struct{}),
+		waitingReasons: make(map[keys.ClusterWideKey]string),
+	}
+
+	object := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "nginx",
+			"namespace": "default",
+		},
+	}}
+	policySpec := &policyv1alpha1.PropagationSpec{
+		// The declared follower is identical to the one the interpreter already returned, so it must be
+		// deduplicated rather than re-checked against the cluster (which would otherwise push it onto the
+		// waiting list every time, masking the fact that it's already propagated as an interpreted follower).
+		Followers: []workv1alpha2.ObjectReference{interpreted},
+	}
+
+	followers, err := d.resolveFollowers(object, policySpec)
+	if err != nil {
+		t.Fatalf("resolveFollowers returned error: %v", err)
+	}
+
+	if len(followers) != 1 {
+		t.Fatalf("expected deduped followers to have length 1, got %d: %v", len(followers), followers)
+	}
+	if followers[0] != interpreted {
+		t.Errorf("expected the single follower to be %v, got %v", interpreted, followers[0])
+	}
+
+	if len(d.waitingObjects) != 0 {
+		t.Errorf("expected no objects to be added to the waiting list for an already-interpreted follower, got %v", d.waitingObjects)
+	}
+}
+
+func TestResolveFollowersNoInterpreterHook(t *testing.T) {
+	d := &ResourceDetector{
+		ResourceInterpreter: &fakeResourceInterpreter{followersEnabled: false},
+		waitingObjects:      make(map[keys.ClusterWideKey]struct{}),
+		waitingReasons:      make(map[keys.ClusterWideKey]string),
+	}
+
+	object := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "nginx",
+			"namespace": "default",
+		},
+	}}
+	policySpec := &policyv1alpha1.PropagationSpec{}
+
+	followers, err := d.resolveFollowers(object, policySpec)
+	if err != nil {
+		t.Fatalf("resolveFollowers returned error: %v", err)
+	}
+	if len(followers) != 0 {
+		t.Errorf("expected no followers when the interpreter hook is disabled and none are declared, got %v", followers)
+	}
+}
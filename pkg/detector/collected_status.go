@@ -0,0 +1,157 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package detector
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/features"
+)
+
+// collectedStatusGVR is the GroupVersionResource of CollectedStatus, used to register its lister alongside
+// the ResourceBinding/ClusterResourceBinding listers maintained by ResourceDetector.
+var collectedStatusGVR = schema.GroupVersionResource{
+	Group:    workv1alpha2.GroupVersion.Group,
+	Version:  workv1alpha2.GroupVersion.Version,
+	Resource: "collectedstatuses",
+}
+
+// clusterCollectedStatusGVR is the GroupVersionResource of ClusterCollectedStatus.
+var clusterCollectedStatusGVR = schema.GroupVersionResource{
+	Group:    workv1alpha2.GroupVersion.Group,
+	Version:  workv1alpha2.GroupVersion.Version,
+	Resource: "clustercollectedstatuses",
+}
+
+// EnsureCollectedStatus makes sure a CollectedStatus exists for the given ResourceBinding, so that per-cluster
+// status aggregation no longer needs to contend with spec writers on the binding itself.
+// Gated behind the CollectedStatus feature so clusters can enable the split incrementally.
+func (d *ResourceDetector) EnsureCollectedStatus(binding *workv1alpha2.ResourceBinding) error {
+	if !features.FeatureGate.Enabled(features.CollectedStatus) {
+		return nil
+	}
+
+	collectedStatus := &workv1alpha2.CollectedStatus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      binding.Name,
+			Namespace: binding.Namespace,
+		},
+	}
+	ownerRef := *metav1.NewControllerRef(binding, workv1alpha2.GroupVersion.WithKind("ResourceBinding"))
+	labels := map[string]string{
+		workv1alpha2.ResourceBindingPermanentIDLabel: binding.Labels[workv1alpha2.ResourceBindingPermanentIDLabel],
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, err := controllerutil.CreateOrUpdate(context.TODO(), d.Client, collectedStatus, func() error {
+			collectedStatus.OwnerReferences = []metav1.OwnerReference{ownerRef}
+			collectedStatus.Labels = labels
+			return nil
+		})
+		return err
+	})
+}
+
+// EnsureClusterCollectedStatus makes sure a ClusterCollectedStatus exists for the given ClusterResourceBinding.
+// Gated behind the CollectedStatus feature so clusters can enable the split incrementally.
+func (d *ResourceDetector) EnsureClusterCollectedStatus(binding *workv1alpha2.ClusterResourceBinding) error {
+	if !features.FeatureGate.Enabled(features.CollectedStatus) {
+		return nil
+	}
+
+	collectedStatus := &workv1alpha2.ClusterCollectedStatus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: binding.Name,
+		},
+	}
+	ownerRef := *metav1.NewControllerRef(binding, workv1alpha2.GroupVersion.WithKind("ClusterResourceBinding"))
+	labels := map[string]string{
+		workv1alpha2.ClusterResourceBindingPermanentIDLabel: binding.Labels[workv1alpha2.ClusterResourceBindingPermanentIDLabel],
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, err := controllerutil.CreateOrUpdate(context.TODO(), d.Client, collectedStatus, func() error {
+			collectedStatus.OwnerReferences = []metav1.OwnerReference{ownerRef}
+			collectedStatus.Labels = labels
+			return nil
+		})
+		return err
+	})
+}
+
+// GetCollectedStatus looks up the CollectedStatus owned by a ResourceBinding without having to read the
+// binding's (now spec-only) status.
+func (d *ResourceDetector) GetCollectedStatus(namespace, name string) (*unstructured.Unstructured, error) {
+	obj, err := d.CollectedStatusLister.ByNamespace(namespace).Get(name)
+	if err != nil {
+		klog.Errorf("Failed to get CollectedStatus(%s/%s): %v", namespace, name, err)
+		return nil, err
+	}
+	unstructuredObj, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("failed to convert CollectedStatus(%s/%s) to unstructured", namespace, name)
+	}
+	return unstructuredObj, nil
+}
+
+// MigrateCollectedStatuses backfills a CollectedStatus/ClusterCollectedStatus for every existing
+// ResourceBinding/ClusterResourceBinding, so operators flipping on the CollectedStatus feature gate on an
+// already-running control plane don't have to wait for the next spec change to get one.
+func (d *ResourceDetector) MigrateCollectedStatuses() error {
+	if !features.FeatureGate.Enabled(features.CollectedStatus) {
+		return nil
+	}
+
+	var errs []error
+
+	rbs := &workv1alpha2.ResourceBindingList{}
+	if err := d.Client.List(context.TODO(), rbs); err != nil {
+		klog.Errorf("Failed to list ResourceBindings for CollectedStatus migration: %v", err)
+		return err
+	}
+	for i := range rbs.Items {
+		if err := d.EnsureCollectedStatus(&rbs.Items[i]); err != nil {
+			klog.Errorf("Failed to migrate CollectedStatus for ResourceBinding(%s/%s): %v",
+				rbs.Items[i].Namespace, rbs.Items[i].Name, err)
+			errs = append(errs, err)
+		}
+	}
+
+	crbs := &workv1alpha2.ClusterResourceBindingList{}
+	if err := d.Client.List(context.TODO(), crbs); err != nil {
+		klog.Errorf("Failed to list ClusterResourceBindings for CollectedStatus migration: %v", err)
+		return err
+	}
+	for i := range crbs.Items {
+		if err := d.EnsureClusterCollectedStatus(&crbs.Items[i]); err != nil {
+			klog.Errorf("Failed to migrate ClusterCollectedStatus for ClusterResourceBinding(%s): %v", crbs.Items[i].Name, err)
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.NewAggregate(errs)
+}
@@ -104,10 +104,31 @@ type ResourceDetector struct {
 	clusterPolicyReconcileWorker   util.AsyncWorker
 	clusterPropagationPolicyLister cache.GenericLister
 
+	// CollectedStatusLister and ClusterCollectedStatusLister let consumers retrieve the per-cluster aggregated
+	// status of a resource template without reading the binding that owns it.
+	CollectedStatusLister        cache.GenericLister
+	ClusterCollectedStatusLister cache.GenericLister
+
+	// followerReconcileWorker maintains a rate limited queue used to store Follower/ClusterFollower keys and
+	// a reconcile function that keeps followers in sync with the leader<->follower index.
+	followerReconcileWorker util.AsyncWorker
+	followerLister          cache.GenericLister
+	clusterFollowerLister   cache.GenericLister
+	// followers indexes the leader<->follower relationships declared via Follower/ClusterFollower objects.
+	followers *followerIndex
+
+	// PropagationMode decides whether ApplyPolicy/ApplyClusterPolicy produce a ResourceBinding/ClusterResourceBinding
+	// (the default) or a FederatedObject/ClusterFederatedObject for matched resource templates. Individual
+	// policies may override this via the PropagationModeAnnotation.
+	PropagationMode PropagationMode
+
 	RESTMapper meta.RESTMapper
 
 	// waitingObjects tracks of objects which haven't been propagated yet as lack of appropriate policies.
 	waitingObjects map[keys.ClusterWideKey]struct{}
+	// waitingReasons records, for a subset of waitingObjects, why they're waiting (e.g. a policy-declared
+	// follower that doesn't exist in the cluster yet). Absence from this map just means "no matched policy".
+	waitingReasons map[keys.ClusterWideKey]string
 	// waitingLock is the lock for waitingObjects operation.
 	waitingLock sync.RWMutex
 	// ConcurrentPropagationPolicySyncs is the number of PropagationPolicy that are allowed to sync concurrently.
@@ -117,11 +138,18 @@ type ResourceDetector struct {
 	// ConcurrentResourceTemplateSyncs is the number of resource templates that are allowed to sync concurrently.
 	// Larger number means responsive resource template syncing but more CPU(and network) load.
 	ConcurrentResourceTemplateSyncs int
+	// ConcurrentPolicyCleanupSyncs is the number of bindings that are allowed to have their policy marks cleaned up
+	// concurrently when a PropagationPolicy/ClusterPropagationPolicy is deleted. Zero means use the package default.
+	ConcurrentPolicyCleanupSyncs int
 
 	// RateLimiterOptions is the configuration for rate limiter which may significantly influence the performance of
 	// the controller.
 	RateLimiterOptions ratelimiterflag.Options
 
+	// CleanupRetryBackoff bounds the retries CleanupResourceTemplateMarks performs against a resource template
+	// before giving up. Nil means use the package default.
+	CleanupRetryBackoff *wait.Backoff
+
 	stopCh <-chan struct{}
 }
 
@@ -129,6 +157,7 @@ type ResourceDetector struct {
 func (d *ResourceDetector) Start(ctx context.Context) error {
 	klog.Infof("Starting resource detector.")
 	d.waitingObjects = make(map[keys.ClusterWideKey]struct{})
+	d.waitingReasons = make(map[keys.ClusterWideKey]string)
 	d.stopCh = ctx.Done()
 
 	// setup policy reconcile worker
@@ -167,6 +196,32 @@ func (d *ResourceDetector) Start(ctx context.Context) error {
 	d.InformerManager.ForResource(clusterPropagationPolicyGVR, clusterPolicyHandler)
 	d.clusterPropagationPolicyLister = d.InformerManager.Lister(clusterPropagationPolicyGVR)
 
+	// watch CollectedStatus/ClusterCollectedStatus so status consumers can read them without going through
+	// the (now spec-only) ResourceBinding/ClusterResourceBinding.
+	d.InformerManager.ForResource(collectedStatusGVR, nil)
+	d.CollectedStatusLister = d.InformerManager.Lister(collectedStatusGVR)
+	d.InformerManager.ForResource(clusterCollectedStatusGVR, nil)
+	d.ClusterCollectedStatusLister = d.InformerManager.Lister(clusterCollectedStatusGVR)
+
+	// watch and index Follower/ClusterFollower changes.
+	d.setupFollowerWatch()
+
+	// backfill CollectedStatus/ClusterCollectedStatus for pre-existing bindings if the feature was just enabled.
+	if err := d.MigrateCollectedStatuses(); err != nil {
+		klog.Errorf("Failed to migrate CollectedStatuses: %v", err)
+	}
+
+	// backfill FederatedObjects for pre-existing ResourceBindings if the detector came up in FederatedObject mode,
+	// so switching PropagationMode on a running control plane doesn't lose scheduling state.
+	if d.PropagationMode == PropagationModeFederatedObject {
+		rbs := &workv1alpha2.ResourceBindingList{}
+		if err := d.Client.List(ctx, rbs); err != nil {
+			klog.Errorf("Failed to list ResourceBindings for FederatedObject migration: %v", err)
+		} else if err := d.MigrateBindingsToFederatedObjects(ctx, rbs.Items); err != nil {
+			klog.Errorf("Failed to migrate ResourceBindings to FederatedObjects: %v", err)
+		}
+	}
+
 	detectorWorkerOptions := util.Options{
 		Name:               "resource detector",
 		KeyFunc:            ResourceItemKeyFunc,
@@ -301,6 +356,13 @@ func (d *ResourceDetector) EventFilter(obj interface{}) bool {
 		return false
 	}
 
+	// A resource matched only as a Follower/ClusterFollower - with no PropagationPolicy of its own - is still
+	// admitted here and routed to the follower path by propagateFollowers, instead of falling into the
+	// waitingObjects list like an ordinary unmatched resource.
+	if d.followers.isFollower(clusterWideKey) {
+		return true
+	}
+
 	// if SkippedPropagatingNamespaces is set, skip object events in these namespaces.
 	for _, nsRegexp := range d.SkippedPropagatingNamespaces {
 		if match := nsRegexp.MatchString(clusterWideKey.Namespace); match {
@@ -472,6 +534,15 @@ func (d *ResourceDetector) ApplyPolicy(object *unstructured.Unstructured, object
 		policyv1alpha1.PropagationPolicyNameAnnotation:      policy.GetName(),
 	}
 
+	if d.propagationModeFor(policy.Annotations) == PropagationModeFederatedObject {
+		operationResult, err = d.applyFederatedObject(object, policyLabels, policyAnnotations, &policy.Spec)
+		if err != nil {
+			return err
+		}
+		d.propagateFollowers(objectKey)
+		return nil
+	}
+
 	binding, err := d.BuildResourceBinding(object, policyLabels, policyAnnotations, &policy.Spec)
 	if err != nil {
 		klog.Errorf("Failed to build resourceBinding for object: %s. error: %v", objectKey, err)
@@ -482,9 +553,13 @@ func (d *ResourceDetector) ApplyPolicy(object *unstructured.Unstructured, object
 		operationResult, err = controllerutil.CreateOrUpdate(context.TODO(), d.Client, bindingCopy, func() error {
 			// If this binding exists and its owner is not the input object, return error and let garbage collector
 			// delete this binding and try again later. See https://github.com/karmada-io/karmada/issues/2090.
-			if ownerRef := metav1.GetControllerOfNoCopy(bindingCopy); ownerRef != nil && ownerRef.UID != object.GetUID() {
-				return fmt.Errorf("failed to update binding due to different owner reference UID, will " +
-					"try again later after binding is garbage collected, see https://github.com/karmada-io/karmada/issues/2090")
+			// With EmbeddedTemplateBinding the binding no longer needs to co-exist with a live template of
+			// matching UID, since it carries the exact template bytes that were scheduled; skip the guard.
+			if !embedTemplateEnabled() {
+				if ownerRef := metav1.GetControllerOfNoCopy(bindingCopy); ownerRef != nil && ownerRef.UID != object.GetUID() {
+					return fmt.Errorf("failed to update binding due to different owner reference UID, will " +
+						"try again later after binding is garbage collected, see https://github.com/karmada-io/karmada/issues/2090")
+				}
 			}
 
 			// Just update necessary fields, especially avoid modifying Spec.Clusters which is scheduling result, if already exists.
@@ -500,6 +575,8 @@ func (d *ResourceDetector) ApplyPolicy(object *unstructured.Unstructured, object
 			bindingCopy.Spec.Placement = binding.Spec.Placement
 			bindingCopy.Spec.Failover = binding.Spec.Failover
 			bindingCopy.Spec.ConflictResolution = binding.Spec.ConflictResolution
+			bindingCopy.Spec.Followers = binding.Spec.Followers
+			bindingCopy.Spec.Template = binding.Spec.Template
 			excludeClusterPolicy(bindingCopy.Labels)
 			return nil
 		})
@@ -521,6 +598,18 @@ func (d *ResourceDetector) ApplyPolicy(object *unstructured.Unstructured, object
 		klog.V(2).Infof("ResourceBinding(%s/%s) is up to date.", binding.GetNamespace(), binding.GetName())
 	}
 
+	if err := d.EnsureCollectedStatus(bindingCopy); err != nil {
+		klog.Errorf("Failed to ensure CollectedStatus for ResourceBinding(%s/%s): %v", binding.GetNamespace(), binding.GetName(), err)
+		return err
+	}
+
+	if err := d.markResourceBindingPolicyBound(bindingCopy); err != nil {
+		klog.Errorf("Failed to set PolicyBound condition on ResourceBinding(%s/%s): %v", binding.GetNamespace(), binding.GetName(), err)
+		return err
+	}
+
+	d.propagateFollowers(objectKey)
+
 	return nil
 }
 
@@ -562,6 +651,19 @@ func (d *ResourceDetector) ApplyClusterPolicy(object *unstructured.Unstructured,
 		policyv1alpha1.ClusterPropagationPolicyAnnotation: policy.GetName(),
 	}
 
+	if d.propagationModeFor(policy.Annotations) == PropagationModeFederatedObject {
+		if object.GetNamespace() != "" {
+			operationResult, err = d.applyFederatedObject(object, policyLabels, policyAnnotations, &policy.Spec)
+		} else {
+			operationResult, err = d.applyClusterFederatedObject(object, policyLabels, policyAnnotations, &policy.Spec)
+		}
+		if err != nil {
+			return err
+		}
+		d.propagateFollowers(objectKey)
+		return nil
+	}
+
 	// Build `ResourceBinding` or `ClusterResourceBinding` according to the resource template's scope.
 	// For namespace-scoped resources, which namespace is not empty, building `ResourceBinding`.
 	// For cluster-scoped resources, which namespace is empty, building `ClusterResourceBinding`.
@@ -576,9 +678,11 @@ func (d *ResourceDetector) ApplyClusterPolicy(object *unstructured.Unstructured,
 			operationResult, err = controllerutil.CreateOrUpdate(context.TODO(), d.Client, bindingCopy, func() error {
 				// If this binding exists and its owner is not the input object, return error and let garbage collector
 				// delete this binding and try again later. See https://github.com/karmada-io/karmada/issues/2090.
-				if ownerRef := metav1.GetControllerOfNoCopy(bindingCopy); ownerRef != nil && ownerRef.UID != object.GetUID() {
-					return fmt.Errorf("failed to update binding due to different owner reference UID, will " +
-						"try again later after binding is garbage collected, see https://github.com/karmada-io/karmada/issues/2090")
+				if !embedTemplateEnabled() {
+					if ownerRef := metav1.GetControllerOfNoCopy(bindingCopy); ownerRef != nil && ownerRef.UID != object.GetUID() {
+						return fmt.Errorf("failed to update binding due to different owner reference UID, will " +
+							"try again later after binding is garbage collected, see https://github.com/karmada-io/karmada/issues/2090")
+					}
 				}
 
 				// Just update necessary fields, especially avoid modifying Spec.Clusters which is scheduling result, if already exists.
@@ -594,6 +698,8 @@ func (d *ResourceDetector) ApplyClusterPolicy(object *unstructured.Unstructured,
 				bindingCopy.Spec.Placement = binding.Spec.Placement
 				bindingCopy.Spec.Failover = binding.Spec.Failover
 				bindingCopy.Spec.ConflictResolution = binding.Spec.ConflictResolution
+				bindingCopy.Spec.Followers = binding.Spec.Followers
+				bindingCopy.Spec.Template = binding.Spec.Template
 				return nil
 			})
 			return err
@@ -611,6 +717,16 @@ func (d *ResourceDetector) ApplyClusterPolicy(object *unstructured.Unstructured,
 		} else {
 			klog.V(2).Infof("ResourceBinding(%s) is up to date.", binding.GetName())
 		}
+
+		if err := d.EnsureCollectedStatus(bindingCopy); err != nil {
+			klog.Errorf("Failed to ensure CollectedStatus for ResourceBinding(%s): %v", binding.GetName(), err)
+			return err
+		}
+
+		if err := d.markResourceBindingPolicyBound(bindingCopy); err != nil {
+			klog.Errorf("Failed to set PolicyBound condition on ResourceBinding(%s): %v", binding.GetName(), err)
+			return err
+		}
 	} else {
 		binding, err := d.BuildClusterResourceBinding(object, policyLabels, policyAnnotations, &policy.Spec)
 		if err != nil {
@@ -622,9 +738,11 @@ func (d *ResourceDetector) ApplyClusterPolicy(object *unstructured.Unstructured,
 			operationResult, err = controllerutil.CreateOrUpdate(context.TODO(), d.Client, bindingCopy, func() error {
 				// If this binding exists and its owner is not the input object, return error and let garbage collector
 				// delete this binding and try again later. See https://github.com/karmada-io/karmada/issues/2090.
-				if ownerRef := metav1.GetControllerOfNoCopy(bindingCopy); ownerRef != nil && ownerRef.UID != object.GetUID() {
-					return fmt.Errorf("failed to update binding due to different owner reference UID, will " +
-						"try again later after binding is garbage collected, see https://github.com/karmada-io/karmada/issues/2090")
+				if !embedTemplateEnabled() {
+					if ownerRef := metav1.GetControllerOfNoCopy(bindingCopy); ownerRef != nil && ownerRef.UID != object.GetUID() {
+						return fmt.Errorf("failed to update binding due to different owner reference UID, will " +
+							"try again later after binding is garbage collected, see https://github.com/karmada-io/karmada/issues/2090")
+					}
 				}
 
 				// Just update necessary fields, especially avoid modifying Spec.Clusters which is scheduling result, if already exists.
@@ -639,6 +757,8 @@ func (d *ResourceDetector) ApplyClusterPolicy(object *unstructured.Unstructured,
 				bindingCopy.Spec.Placement = binding.Spec.Placement
 				bindingCopy.Spec.Failover = binding.Spec.Failover
 				bindingCopy.Spec.ConflictResolution = binding.Spec.ConflictResolution
+				bindingCopy.Spec.Followers = binding.Spec.Followers
+				bindingCopy.Spec.Template = binding.Spec.Template
 				return nil
 			})
 			return err
@@ -656,8 +776,20 @@ func (d *ResourceDetector) ApplyClusterPolicy(object *unstructured.Unstructured,
 		} else {
 			klog.V(2).Infof("ClusterResourceBinding(%s) is up to date.", binding.GetName())
 		}
+
+		if err := d.EnsureClusterCollectedStatus(bindingCopy); err != nil {
+			klog.Errorf("Failed to ensure ClusterCollectedStatus for ClusterResourceBinding(%s): %v", binding.GetName(), err)
+			return err
+		}
+
+		if err := d.markClusterResourceBindingPolicyBound(bindingCopy); err != nil {
+			klog.Errorf("Failed to set PolicyBound condition on ClusterResourceBinding(%s): %v", binding.GetName(), err)
+			return err
+		}
 	}
 
+	d.propagateFollowers(objectKey)
+
 	return nil
 }
 
@@ -786,6 +918,20 @@ func (d *ResourceDetector) BuildResourceBinding(object *unstructured.Unstructure
 		propagationBinding.Spec.ReplicaRequirements = replicaRequirements
 	}
 
+	if embedTemplateEnabled() {
+		template, err := embedTemplate(object)
+		if err != nil {
+			return nil, err
+		}
+		propagationBinding.Spec.Template = template
+	}
+
+	followers, err := d.resolveFollowers(object, policySpec)
+	if err != nil {
+		return nil, err
+	}
+	propagationBinding.Spec.Followers = followers
+
 	return propagationBinding, nil
 }
 
@@ -829,6 +975,20 @@ func (d *ResourceDetector) BuildClusterResourceBinding(object *unstructured.Unst
 		binding.Spec.ReplicaRequirements = replicaRequirements
 	}
 
+	if embedTemplateEnabled() {
+		template, err := embedTemplate(object)
+		if err != nil {
+			return nil, err
+		}
+		binding.Spec.Template = template
+	}
+
+	followers, err := d.resolveFollowers(object, policySpec)
+	if err != nil {
+		return nil, err
+	}
+	binding.Spec.Followers = followers
+
 	return binding, nil
 }
 
@@ -849,12 +1009,25 @@ func (d *ResourceDetector) AddWaiting(objectKey keys.ClusterWideKey) {
 	klog.V(1).Infof("Add object(%s) to waiting list, length of list is: %d", objectKey.String(), len(d.waitingObjects))
 }
 
+// AddWaitingWithReason adds object's key to the waiting list along with a human-readable reason (e.g. a
+// policy-declared follower that hasn't shown up in the cluster yet), so it can be surfaced through logs/status
+// instead of looking indistinguishable from an object that simply lacks a matching policy.
+func (d *ResourceDetector) AddWaitingWithReason(objectKey keys.ClusterWideKey, reason string) {
+	d.waitingLock.Lock()
+	defer d.waitingLock.Unlock()
+
+	d.waitingObjects[objectKey] = struct{}{}
+	d.waitingReasons[objectKey] = reason
+	klog.V(1).Infof("Add object(%s) to waiting list (%s), length of list is: %d", objectKey.String(), reason, len(d.waitingObjects))
+}
+
 // RemoveWaiting removes object's key from waiting list.
 func (d *ResourceDetector) RemoveWaiting(objectKey keys.ClusterWideKey) {
 	d.waitingLock.Lock()
 	defer d.waitingLock.Unlock()
 
 	delete(d.waitingObjects, objectKey)
+	delete(d.waitingReasons, objectKey)
 }
 
 // GetMatching gets objects keys in waiting list that matches one of resource selectors.
@@ -1101,28 +1274,16 @@ func (d *ResourceDetector) HandlePropagationPolicyDeletion(policyID string) erro
 	cleanupMarksFunc := func(obj metav1.Object) {
 		util.RemoveLabels(obj, propagationPolicyMarkedLabels...)
 		util.RemoveAnnotations(obj, propagationPolicyMarkedAnnotations...)
-	}
-	var errs []error
-	for index, binding := range rbs.Items {
-		// Must remove the marks, such as labels and annotations, from the resource template ahead of ResourceBinding,
-		// otherwise might lose the chance to do that in a retry loop (in particular, the marks was successfully removed
-		// from ResourceBinding, but resource template not), since the ResourceBinding will not be listed again.
-		if err := d.CleanupResourceTemplateMarks(binding.Spec.Resource, cleanupMarksFunc); err != nil {
-			klog.Errorf("Failed to clean up marks from resource(%s-%s/%s) when propagationPolicy removed, error: %v",
-				binding.Spec.Resource.Kind, binding.Spec.Resource.Namespace, binding.Spec.Resource.Name, err)
-			errs = append(errs, err)
-			// Skip cleaning up policy labels and annotations from ResourceBinding, give a chance to do that in a retry loop.
-			continue
-		}
-
-		// Clean up the marks from the reference binding so that the karmada scheduler won't reschedule the binding.
-		if err := d.CleanupResourceBindingMarks(&rbs.Items[index], cleanupMarksFunc); err != nil {
-			klog.Errorf("Failed to clean up marks from resource binding(%s/%s) when propagationPolicy removed, error: %v",
-				binding.Namespace, binding.Name, err)
-			errs = append(errs, err)
+		if rb, ok := obj.(*workv1alpha2.ResourceBinding); ok {
+			rb.SetCondition(metav1.Condition{
+				Type:    workv1alpha2.PolicyBoundConditionType,
+				Status:  metav1.ConditionFalse,
+				Reason:  workv1alpha2.PolicyReleasedReason,
+				Message: "PropagationPolicy that claimed this binding was deleted",
+			})
 		}
 	}
-	return errors.NewAggregate(errs)
+	return errors.NewAggregate(d.cleanupResourceBindingsInParallel(rbs.Items, cleanupMarksFunc))
 }
 
 // HandleClusterPropagationPolicyDeletion handles ClusterPropagationPolicy delete event.
@@ -1139,6 +1300,18 @@ func (d *ResourceDetector) HandleClusterPropagationPolicyDeletion(policyID strin
 	cleanupMarksFun := func(obj metav1.Object) {
 		util.RemoveLabels(obj, clusterPropagationPolicyMarkedLabels...)
 		util.RemoveAnnotations(obj, clusterPropagationPolicyMarkedAnnotations...)
+		condition := metav1.Condition{
+			Type:    workv1alpha2.PolicyBoundConditionType,
+			Status:  metav1.ConditionFalse,
+			Reason:  workv1alpha2.PolicyReleasedReason,
+			Message: "ClusterPropagationPolicy that claimed this binding was deleted",
+		}
+		switch typed := obj.(type) {
+		case *workv1alpha2.ResourceBinding:
+			typed.SetCondition(condition)
+		case *workv1alpha2.ClusterResourceBinding:
+			typed.SetCondition(condition)
+		}
 	}
 
 	// load the ClusterResourceBindings which labeled with current policy
@@ -1147,25 +1320,7 @@ func (d *ResourceDetector) HandleClusterPropagationPolicyDeletion(policyID strin
 		klog.Errorf("Failed to list clusterResourceBindings with clusterPropagationPolicy permanentID(%s), error: %v", policyID, err)
 		errs = append(errs, err)
 	} else if len(crbs.Items) > 0 {
-		for index, binding := range crbs.Items {
-			// Must remove the marks, such as labels and annotations, from the resource template ahead of
-			// ClusterResourceBinding, otherwise might lose the chance to do that in a retry loop (in particular, the
-			// marks was successfully removed from ClusterResourceBinding, but resource template not), since the
-			// ClusterResourceBinding will not be listed again.
-			if err := d.CleanupResourceTemplateMarks(binding.Spec.Resource, cleanupMarksFun); err != nil {
-				klog.Errorf("Failed to clean up marks from resource(%s-%s) when clusterPropagationPolicy removed, error: %v",
-					binding.Spec.Resource.Kind, binding.Spec.Resource.Name, err)
-				// Skip cleaning up policy labels and annotations from ClusterResourceBinding, give a chance to do that in a retry loop.
-				continue
-			}
-
-			// Clean up the marks from the reference binding so that the Karmada scheduler won't reschedule the binding.
-			if err := d.CleanupClusterResourceBindingMarks(&crbs.Items[index], cleanupMarksFun); err != nil {
-				klog.Errorf("Failed to clean up marks from clusterResourceBinding(%s) when clusterPropagationPolicy removed, error: %v",
-					binding.Name, err)
-				errs = append(errs, err)
-			}
-		}
+		errs = append(errs, d.cleanupClusterResourceBindingsInParallel(crbs.Items, cleanupMarksFun)...)
 	}
 
 	// load the ResourceBindings which labeled with current policy
@@ -1174,25 +1329,7 @@ func (d *ResourceDetector) HandleClusterPropagationPolicyDeletion(policyID strin
 		klog.Errorf("Failed to list resourceBindings with clusterPropagationPolicy permanentID(%s), error: %v", policyID, err)
 		errs = append(errs, err)
 	} else if len(rbs.Items) > 0 {
-		for index, binding := range rbs.Items {
-			// Must remove the marks, such as labels and annotations, from the resource template ahead of ResourceBinding,
-			// otherwise might lose the chance to do that in a retry loop (in particular, the label was successfully
-			// removed from ResourceBinding, but resource template not), since the ResourceBinding will not be listed again.
-			if err := d.CleanupResourceTemplateMarks(binding.Spec.Resource, cleanupMarksFun); err != nil {
-				klog.Errorf("Failed to clean up marks from resource(%s-%s/%s) when clusterPropagationPolicy removed, error: %v",
-					binding.Spec.Resource.Kind, binding.Spec.Resource.Namespace, binding.Spec.Resource.Name, err)
-				errs = append(errs, err)
-				// Skip cleaning up policy labels and annotations from ResourceBinding, give a chance to do that in a retry loop.
-				continue
-			}
-
-			// Clean up the marks from the reference binding so that the Karmada scheduler won't reschedule the binding.
-			if err := d.CleanupResourceBindingMarks(&rbs.Items[index], cleanupMarksFun); err != nil {
-				klog.Errorf("Failed to clean up marks from resourceBinding(%s/%s) when clusterPropagationPolicy removed, error: %v",
-					binding.Namespace, binding.Name, err)
-				errs = append(errs, err)
-			}
-		}
+		errs = append(errs, d.cleanupResourceBindingsInParallel(rbs.Items, cleanupMarksFun)...)
 	}
 	return errors.NewAggregate(errs)
 }
@@ -1328,36 +1465,118 @@ func (d *ResourceDetector) HandleClusterPropagationPolicyCreationOrUpdate(policy
 	return nil
 }
 
+// markResourceBindingPolicyBound sets the PolicyBound/PolicyMatched condition on a ResourceBinding, so that
+// policy attachment is observable via `kubectl get rb -o yaml` rather than only through labels.
+func (d *ResourceDetector) markResourceBindingPolicyBound(rb *workv1alpha2.ResourceBinding) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+		rb.SetCondition(metav1.Condition{
+			Type:    workv1alpha2.PolicyBoundConditionType,
+			Status:  metav1.ConditionTrue,
+			Reason:  workv1alpha2.PolicyMatchedReason,
+			Message: "Matched by a PropagationPolicy/ClusterPropagationPolicy",
+		})
+		updateErr := d.Client.Status().Update(context.TODO(), rb)
+		if updateErr == nil {
+			return nil
+		}
+
+		updated := &workv1alpha2.ResourceBinding{}
+		if err = d.Client.Get(context.TODO(), client.ObjectKey{Namespace: rb.GetNamespace(), Name: rb.GetName()}, updated); err == nil {
+			rb = updated.DeepCopy()
+		} else {
+			klog.Errorf("Failed to get updated resource binding %s/%s: %v", rb.GetNamespace(), rb.GetName(), err)
+		}
+		return updateErr
+	})
+}
+
+// markClusterResourceBindingPolicyBound is the cluster-scoped counterpart of markResourceBindingPolicyBound.
+func (d *ResourceDetector) markClusterResourceBindingPolicyBound(crb *workv1alpha2.ClusterResourceBinding) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+		crb.SetCondition(metav1.Condition{
+			Type:    workv1alpha2.PolicyBoundConditionType,
+			Status:  metav1.ConditionTrue,
+			Reason:  workv1alpha2.PolicyMatchedReason,
+			Message: "Matched by a ClusterPropagationPolicy",
+		})
+		updateErr := d.Client.Status().Update(context.TODO(), crb)
+		if updateErr == nil {
+			return nil
+		}
+
+		updated := &workv1alpha2.ClusterResourceBinding{}
+		if err = d.Client.Get(context.TODO(), client.ObjectKey{Name: crb.GetName()}, updated); err == nil {
+			crb = updated.DeepCopy()
+		} else {
+			klog.Errorf("Failed to get updated cluster resource binding %s: %v", crb.GetName(), err)
+		}
+		return updateErr
+	})
+}
+
 // CleanupResourceTemplateMarks removes marks, such as labels and annotations, from object referencing by objRef.
 func (d *ResourceDetector) CleanupResourceTemplateMarks(objRef workv1alpha2.ObjectReference, cleanupFunc func(obj metav1.Object)) error {
-	workload, err := helper.FetchResourceTemplate(d.DynamicClient, d.InformerManager, d.RESTMapper, objRef)
-	if err != nil {
-		// do nothing if resource template not exist, it might have been removed.
-		if apierrors.IsNotFound(err) {
-			return nil
+	backoff := d.cleanupRetryBackoff()
+	err := retry.OnError(backoff, func(err error) bool {
+		// Anything other than "already gone" is worth retrying: conflicts from a concurrent writer, and
+		// transient apiserver errors alike. Each attempt re-fetches the template so labels/annotations are
+		// recomputed against the latest object rather than replaying a stale mutation.
+		return !apierrors.IsNotFound(err)
+	}, func() error {
+		workload, err := helper.FetchResourceTemplate(d.DynamicClient, d.InformerManager, d.RESTMapper, objRef)
+		if err != nil {
+			// do nothing if resource template not exist, it might have been removed.
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			klog.Errorf("Failed to fetch resource(kind=%s, %s/%s): %v", objRef.Kind, objRef.Namespace, objRef.Name, err)
+			return err
 		}
-		klog.Errorf("Failed to fetch resource(kind=%s, %s/%s): %v", objRef.Kind, objRef.Namespace, objRef.Name, err)
-		return err
-	}
 
-	workload = workload.DeepCopy()
-	cleanupFunc(workload)
+		workload = workload.DeepCopy()
+		cleanupFunc(workload)
 
-	gvr, err := restmapper.GetGroupVersionResource(d.RESTMapper, workload.GroupVersionKind())
-	if err != nil {
-		klog.Errorf("Failed to delete resource(%s/%s) labels as mapping GVK to GVR failed: %v", workload.GetNamespace(), workload.GetName(), err)
-		return err
-	}
+		gvr, err := restmapper.GetGroupVersionResource(d.RESTMapper, workload.GroupVersionKind())
+		if err != nil {
+			klog.Errorf("Failed to delete resource(%s/%s) labels as mapping GVK to GVR failed: %v", workload.GetNamespace(), workload.GetName(), err)
+			return err
+		}
+
+		newWorkload, err := d.DynamicClient.Resource(gvr).Namespace(workload.GetNamespace()).Update(context.TODO(), workload, metav1.UpdateOptions{})
+		if err != nil {
+			klog.Errorf("Failed to update resource %v/%v, err is %v ", workload.GetNamespace(), workload.GetName(), err)
+			return err
+		}
+		klog.V(2).Infof("Updated resource template(kind=%s, %s/%s) successfully", newWorkload.GetKind(), newWorkload.GetNamespace(), newWorkload.GetName())
+		return nil
+	})
 
-	newWorkload, err := d.DynamicClient.Resource(gvr).Namespace(workload.GetNamespace()).Update(context.TODO(), workload, metav1.UpdateOptions{})
 	if err != nil {
-		klog.Errorf("Failed to update resource %v/%v, err is %v ", workload.GetNamespace(), workload.GetName(), err)
+		klog.Errorf("Abandoned cleaning up marks on resource(kind=%s, %s/%s) after %d attempts: %v",
+			objRef.Kind, objRef.Namespace, objRef.Name, backoff.Steps, err)
+		d.EventRecorder.Eventf(&corev1.ObjectReference{
+			Kind: objRef.Kind, Namespace: objRef.Namespace, Name: objRef.Name, APIVersion: objRef.APIVersion,
+		}, corev1.EventTypeWarning, events.EventReasonCleanupResourceTemplateMarksFailed,
+			"Cleaning up marks permanently failed after retry budget exhausted: %v", err)
 		return err
 	}
-	klog.V(2).Infof("Updated resource template(kind=%s, %s/%s) successfully", newWorkload.GetKind(), newWorkload.GetNamespace(), newWorkload.GetName())
 	return nil
 }
 
+// cleanupRetryBackoff returns the configured retry budget for CleanupResourceTemplateMarks, falling back to a
+// sane default when the detector wasn't given one.
+func (d *ResourceDetector) cleanupRetryBackoff() wait.Backoff {
+	if d.CleanupRetryBackoff != nil {
+		return *d.CleanupRetryBackoff
+	}
+	return wait.Backoff{
+		Duration: 100 * time.Millisecond,
+		Factor:   2.0,
+		Steps:    5,
+		Cap:      10 * time.Second,
+	}
+}
+
 // CleanupResourceBindingMarks removes marks, such as labels and annotations, from resource binding.
 func (d *ResourceDetector) CleanupResourceBindingMarks(rb *workv1alpha2.ResourceBinding, cleanupFunc func(obj metav1.Object)) error {
 	return retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
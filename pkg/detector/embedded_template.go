@@ -0,0 +1,67 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package detector
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/features"
+)
+
+// embedTemplateEnabled reports whether the EmbeddedTemplateBinding feature is on, in which case
+// BuildResourceBinding/BuildClusterResourceBinding embed the resource template verbatim instead of
+// pointing back to it via Spec.Resource.
+func embedTemplateEnabled() bool {
+	return features.FeatureGate.Enabled(features.EmbeddedTemplateBinding)
+}
+
+// embedTemplate snapshots object into binding's Spec.Template, see marshalTemplate for what gets stripped.
+// Consumers that build work from the binding should prefer ResourceTemplateOf over re-fetching the template
+// from the source cluster's informer when this is set.
+func embedTemplate(object *unstructured.Unstructured) (*runtime.RawExtension, error) {
+	templateBytes, err := marshalTemplate(object)
+	if err != nil {
+		klog.Errorf("Failed to snapshot template(%s/%s) for embedded binding: %v", object.GetNamespace(), object.GetName(), err)
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: templateBytes}, nil
+}
+
+// ResourceTemplateOf returns the resource template carried by a ResourceBinding, reading it from
+// Spec.Template when the embedded-template mode produced it, and falling back to re-decoding Spec.Resource
+// as a bare object reference otherwise.
+func ResourceTemplateOf(binding *workv1alpha2.ResourceBinding) (*unstructured.Unstructured, error) {
+	if binding.Spec.Template != nil && len(binding.Spec.Template.Raw) > 0 {
+		template := &unstructured.Unstructured{}
+		if err := json.Unmarshal(binding.Spec.Template.Raw, &template.Object); err != nil {
+			return nil, err
+		}
+		return template, nil
+	}
+
+	template := &unstructured.Unstructured{}
+	template.SetAPIVersion(binding.Spec.Resource.APIVersion)
+	template.SetKind(binding.Spec.Resource.Kind)
+	template.SetNamespace(binding.Spec.Resource.Namespace)
+	template.SetName(binding.Spec.Resource.Name)
+	return template, nil
+}
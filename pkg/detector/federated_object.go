@@ -0,0 +1,258 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package detector
+
+import (
+	"context"
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/fedinformer/keys"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+// PropagationMode decides which kind of object ResourceDetector produces from a matched resource template.
+type PropagationMode string
+
+const (
+	// PropagationModeBinding is the default mode: one ResourceBinding/ClusterResourceBinding per template,
+	// as built by BuildResourceBinding/BuildClusterResourceBinding.
+	PropagationModeBinding PropagationMode = "Binding"
+	// PropagationModeFederatedObject packages the raw template bytes into a FederatedObject/ClusterFederatedObject
+	// instead, so arbitrary/unknown GVKs can be propagated without teaching Karmada their structure.
+	PropagationModeFederatedObject PropagationMode = "FederatedObject"
+)
+
+// PropagationModeAnnotation lets a single PropagationPolicy/ClusterPropagationPolicy override the
+// detector-wide PropagationMode for the resources it matches.
+const PropagationModeAnnotation = "propagationpolicy.karmada.io/propagation-mode"
+
+// propagationModeFor resolves the effective PropagationMode for a policy, falling back to the
+// detector-wide default when the policy carries no override annotation or an unrecognized value in it.
+func (d *ResourceDetector) propagationModeFor(policyAnnotations map[string]string) PropagationMode {
+	if mode, ok := policyAnnotations[PropagationModeAnnotation]; ok {
+		switch PropagationMode(mode) {
+		case PropagationModeBinding, PropagationModeFederatedObject:
+			return PropagationMode(mode)
+		}
+	}
+	return d.PropagationMode
+}
+
+// buildFederatedObject packages object's raw template, the resolved placement and declared followers into a
+// FederatedObject, as an alternative to BuildResourceBinding for GVKs the ResourceInterpreter has no rules for.
+func (d *ResourceDetector) buildFederatedObject(object *unstructured.Unstructured,
+	labels, annotations map[string]string, policySpec *policyv1alpha1.PropagationSpec) (*workv1alpha2.FederatedObject, error) {
+	templateBytes, err := marshalTemplate(object)
+	if err != nil {
+		klog.Errorf("Failed to marshal template(%s/%s) for FederatedObject: %v", object.GetNamespace(), object.GetName(), err)
+		return nil, err
+	}
+
+	return &workv1alpha2.FederatedObject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.GenerateBindingName(object.GetKind(), object.GetName()),
+			Namespace: object.GetNamespace(),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(object, object.GroupVersionKind()),
+			},
+			Annotations: annotations,
+			Labels:      labels,
+			Finalizers:  []string{util.BindingControllerFinalizer},
+		},
+		Spec: workv1alpha2.FederatedObjectSpec{
+			Template:  runtime.RawExtension{Raw: templateBytes},
+			Placement: &policySpec.Placement,
+			Followers: d.followerReferences(keys.ClusterWideKey{
+				Group:     object.GroupVersionKind().Group,
+				Version:   object.GroupVersionKind().Version,
+				Kind:      object.GetKind(),
+				Namespace: object.GetNamespace(),
+				Name:      object.GetName(),
+			}),
+		},
+	}, nil
+}
+
+// buildClusterFederatedObject is the cluster-scoped counterpart of buildFederatedObject.
+func (d *ResourceDetector) buildClusterFederatedObject(object *unstructured.Unstructured,
+	labels, annotations map[string]string, policySpec *policyv1alpha1.PropagationSpec) (*workv1alpha2.ClusterFederatedObject, error) {
+	templateBytes, err := marshalTemplate(object)
+	if err != nil {
+		klog.Errorf("Failed to marshal template(%s) for ClusterFederatedObject: %v", object.GetName(), err)
+		return nil, err
+	}
+
+	return &workv1alpha2.ClusterFederatedObject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: names.GenerateBindingName(object.GetKind(), object.GetName()),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(object, object.GroupVersionKind()),
+			},
+			Annotations: annotations,
+			Labels:      labels,
+			Finalizers:  []string{util.ClusterResourceBindingControllerFinalizer},
+		},
+		Spec: workv1alpha2.FederatedObjectSpec{
+			Template:  runtime.RawExtension{Raw: templateBytes},
+			Placement: &policySpec.Placement,
+		},
+	}, nil
+}
+
+// marshalTemplate snapshots object, strips server-side/status fields that shouldn't be replayed to member
+// clusters, and returns it as JSON suitable for Spec.Template.
+func marshalTemplate(object *unstructured.Unstructured) ([]byte, error) {
+	template := object.DeepCopy()
+	unstructured.RemoveNestedField(template.Object, "status")
+	unstructured.RemoveNestedField(template.Object, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(template.Object, "metadata", "uid")
+	unstructured.RemoveNestedField(template.Object, "metadata", "generation")
+	unstructured.RemoveNestedField(template.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(template.Object, "metadata", "ownerReferences")
+	return json.Marshal(template.Object)
+}
+
+// applyFederatedObject builds and create-or-updates a FederatedObject for object, mirroring the
+// create-or-update loop ApplyPolicy runs for ResourceBinding.
+func (d *ResourceDetector) applyFederatedObject(object *unstructured.Unstructured,
+	labels, annotations map[string]string, policySpec *policyv1alpha1.PropagationSpec) (controllerutil.OperationResult, error) {
+	federatedObject, err := d.buildFederatedObject(object, labels, annotations, policySpec)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	federatedObjectCopy := federatedObject.DeepCopy()
+	var operationResult controllerutil.OperationResult
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+		operationResult, err = controllerutil.CreateOrUpdate(context.TODO(), d.Client, federatedObjectCopy, func() error {
+			federatedObjectCopy.Annotations = util.DedupeAndMergeAnnotations(federatedObjectCopy.Annotations, federatedObject.Annotations)
+			federatedObjectCopy.Labels = util.DedupeAndMergeLabels(federatedObjectCopy.Labels, federatedObject.Labels)
+			federatedObjectCopy.OwnerReferences = federatedObject.OwnerReferences
+			federatedObjectCopy.Finalizers = federatedObject.Finalizers
+			federatedObjectCopy.Spec = federatedObject.Spec
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		klog.Errorf("Failed to apply FederatedObject(%s/%s): %v", federatedObject.GetNamespace(), federatedObject.GetName(), err)
+		return operationResult, err
+	}
+
+	klog.V(2).Infof("FederatedObject(%s/%s) is up to date.", federatedObject.GetNamespace(), federatedObject.GetName())
+	return operationResult, nil
+}
+
+// applyClusterFederatedObject is the cluster-scoped counterpart of applyFederatedObject.
+func (d *ResourceDetector) applyClusterFederatedObject(object *unstructured.Unstructured,
+	labels, annotations map[string]string, policySpec *policyv1alpha1.PropagationSpec) (controllerutil.OperationResult, error) {
+	federatedObject, err := d.buildClusterFederatedObject(object, labels, annotations, policySpec)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	federatedObjectCopy := federatedObject.DeepCopy()
+	var operationResult controllerutil.OperationResult
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+		operationResult, err = controllerutil.CreateOrUpdate(context.TODO(), d.Client, federatedObjectCopy, func() error {
+			federatedObjectCopy.Annotations = util.DedupeAndMergeAnnotations(federatedObjectCopy.Annotations, federatedObject.Annotations)
+			federatedObjectCopy.Labels = util.DedupeAndMergeLabels(federatedObjectCopy.Labels, federatedObject.Labels)
+			federatedObjectCopy.OwnerReferences = federatedObject.OwnerReferences
+			federatedObjectCopy.Finalizers = federatedObject.Finalizers
+			federatedObjectCopy.Spec = federatedObject.Spec
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		klog.Errorf("Failed to apply ClusterFederatedObject(%s): %v", federatedObject.GetName(), err)
+		return operationResult, err
+	}
+
+	klog.V(2).Infof("ClusterFederatedObject(%s) is up to date.", federatedObject.GetName())
+	return operationResult, nil
+}
+
+// MigrateBindingsToFederatedObjects converts existing ResourceBindings, keyed by their permanent-ID label,
+// into the equivalent FederatedObject so that flipping PropagationMode on a running control plane doesn't
+// lose scheduling state. Each migrated FederatedObject carries a fresh snapshot of the resource template
+// (without one, execution controllers have nothing to apply to member clusters) plus the binding's resolved
+// placement and the followers declared for its resource template through Follower/ClusterFollower objects.
+func (d *ResourceDetector) MigrateBindingsToFederatedObjects(ctx context.Context, bindings []workv1alpha2.ResourceBinding) error {
+	var errs []error
+
+	for i := range bindings {
+		binding := &bindings[i]
+
+		workload, err := helper.FetchResourceTemplate(d.DynamicClient, d.InformerManager, d.RESTMapper, binding.Spec.Resource)
+		if err != nil {
+			klog.Errorf("Failed to fetch resource template for ResourceBinding(%s/%s) migration: %v", binding.Namespace, binding.Name, err)
+			errs = append(errs, err)
+			continue
+		}
+		templateBytes, err := marshalTemplate(workload)
+		if err != nil {
+			klog.Errorf("Failed to marshal resource template for ResourceBinding(%s/%s) migration: %v", binding.Namespace, binding.Name, err)
+			errs = append(errs, err)
+			continue
+		}
+
+		resourceKey, err := helper.ConstructClusterWideKey(binding.Spec.Resource)
+		if err != nil {
+			klog.Errorf("Failed to build key for ResourceBinding(%s/%s) migration: %v", binding.Namespace, binding.Name, err)
+			errs = append(errs, err)
+			continue
+		}
+		followers := d.followerReferences(resourceKey)
+
+		federatedObject := &workv1alpha2.FederatedObject{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        binding.Name,
+				Namespace:   binding.Namespace,
+				Labels:      binding.Labels,
+				Annotations: binding.Annotations,
+			},
+		}
+
+		if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			_, err := controllerutil.CreateOrUpdate(ctx, d.Client, federatedObject, func() error {
+				federatedObject.Spec.Template = runtime.RawExtension{Raw: templateBytes}
+				federatedObject.Spec.Placement = binding.Spec.Placement
+				federatedObject.Spec.Followers = followers
+				return nil
+			})
+			return err
+		}); err != nil {
+			klog.Errorf("Failed to migrate ResourceBinding(%s/%s) to FederatedObject: %v", binding.Namespace, binding.Name, err)
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.NewAggregate(errs)
+}
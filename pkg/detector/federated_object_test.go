@@ -0,0 +1,85 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package detector
+
+import (
+	"encoding/json"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMarshalTemplateStripsServerSideFields(t *testing.T) {
+	object := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":            "nginx",
+				"namespace":       "default",
+				"resourceVersion": "12345",
+				"uid":             "1f2e3d4c",
+				"generation":      int64(2),
+				"managedFields":   []interface{}{map[string]interface{}{"manager": "kubectl"}},
+				"ownerReferences": []interface{}{map[string]interface{}{"kind": "ReplicaSet", "name": "nginx-abc"}},
+			},
+			"spec": map[string]interface{}{
+				"replicas": int64(3),
+			},
+			"status": map[string]interface{}{
+				"readyReplicas": int64(1),
+			},
+		},
+	}
+
+	raw, err := marshalTemplate(object)
+	if err != nil {
+		t.Fatalf("marshalTemplate returned error: %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatalf("failed to unmarshal marshalTemplate output: %v", err)
+	}
+
+	if _, ok := got["status"]; ok {
+		t.Errorf("expected status to be stripped, got: %v", got["status"])
+	}
+
+	metadata, ok := got["metadata"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected metadata to be a map, got: %T", got["metadata"])
+	}
+	for _, field := range []string{"resourceVersion", "uid", "generation", "managedFields", "ownerReferences"} {
+		if _, ok := metadata[field]; ok {
+			t.Errorf("expected metadata.%s to be stripped, got: %v", field, metadata[field])
+		}
+	}
+	if metadata["name"] != "nginx" || metadata["namespace"] != "default" {
+		t.Errorf("expected metadata.name/namespace to be preserved, got: %v", metadata)
+	}
+
+	spec, ok := got["spec"].(map[string]interface{})
+	if !ok || spec["replicas"] != float64(3) {
+		t.Errorf("expected spec to be preserved untouched, got: %v", got["spec"])
+	}
+
+	// marshalTemplate must not mutate the caller's object.
+	if _, ok := object.Object["status"]; !ok {
+		t.Errorf("marshalTemplate must not mutate the input object, but status was removed from it")
+	}
+}
@@ -0,0 +1,366 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package detector
+
+import (
+	"context"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/fedinformer"
+	"github.com/karmada-io/karmada/pkg/util/fedinformer/keys"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+// followerGVR is the GroupVersionResource of Follower.
+var followerGVR = schema.GroupVersionResource{
+	Group:    policyv1alpha1.GroupVersion.Group,
+	Version:  policyv1alpha1.GroupVersion.Version,
+	Resource: "followers",
+}
+
+// clusterFollowerGVR is the GroupVersionResource of ClusterFollower.
+var clusterFollowerGVR = schema.GroupVersionResource{
+	Group:    policyv1alpha1.GroupVersion.Group,
+	Version:  policyv1alpha1.GroupVersion.Version,
+	Resource: "clusterfollowers",
+}
+
+// followerIndex maintains a bidirectional mapping between a leader's ClusterWideKey and the ClusterWideKeys
+// of the followers declared to tag along with it, as set up by Follower/ClusterFollower objects.
+type followerIndex struct {
+	lock          sync.RWMutex
+	leaderToFollo map[keys.ClusterWideKey][]keys.ClusterWideKey
+	followerToLea map[keys.ClusterWideKey][]keys.ClusterWideKey
+}
+
+func newFollowerIndex() *followerIndex {
+	return &followerIndex{
+		leaderToFollo: make(map[keys.ClusterWideKey][]keys.ClusterWideKey),
+		followerToLea: make(map[keys.ClusterWideKey][]keys.ClusterWideKey),
+	}
+}
+
+// set replaces, for a given follower, the complete list of leaders it follows.
+func (i *followerIndex) set(followerKey keys.ClusterWideKey, leaderKeys []keys.ClusterWideKey) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	for _, oldLeaderKey := range i.followerToLea[followerKey] {
+		i.leaderToFollo[oldLeaderKey] = removeKey(i.leaderToFollo[oldLeaderKey], followerKey)
+	}
+
+	i.followerToLea[followerKey] = leaderKeys
+	for _, leaderKey := range leaderKeys {
+		i.leaderToFollo[leaderKey] = appendKeyIfMissing(i.leaderToFollo[leaderKey], followerKey)
+	}
+}
+
+// delete removes a follower from the index entirely, e.g. when its Follower/ClusterFollower object is deleted.
+func (i *followerIndex) delete(followerKey keys.ClusterWideKey) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	for _, leaderKey := range i.followerToLea[followerKey] {
+		i.leaderToFollo[leaderKey] = removeKey(i.leaderToFollo[leaderKey], followerKey)
+	}
+	delete(i.followerToLea, followerKey)
+}
+
+// followersOf returns the followers declared for the given leader.
+func (i *followerIndex) followersOf(leaderKey keys.ClusterWideKey) []keys.ClusterWideKey {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	return append([]keys.ClusterWideKey(nil), i.leaderToFollo[leaderKey]...)
+}
+
+// isFollower reports whether the given key has at least one declared leader.
+func (i *followerIndex) isFollower(followerKey keys.ClusterWideKey) bool {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	return len(i.followerToLea[followerKey]) > 0
+}
+
+func removeKey(s []keys.ClusterWideKey, target keys.ClusterWideKey) []keys.ClusterWideKey {
+	for idx, k := range s {
+		if k == target {
+			return append(s[:idx], s[idx+1:]...)
+		}
+	}
+	return s
+}
+
+func appendKeyIfMissing(s []keys.ClusterWideKey, target keys.ClusterWideKey) []keys.ClusterWideKey {
+	for _, k := range s {
+		if k == target {
+			return s
+		}
+	}
+	return append(s, target)
+}
+
+// setupFollowerWatch wires up the informer and reconcile worker that keep ResourceDetector's followerIndex
+// in sync with Follower/ClusterFollower objects, alongside policyReconcileWorker/clusterPolicyReconcileWorker.
+func (d *ResourceDetector) setupFollowerWatch() {
+	d.followers = newFollowerIndex()
+
+	followerWorkerOptions := util.Options{
+		Name:          "follower reconciler",
+		KeyFunc:       ClusterWideKeyFunc,
+		ReconcileFunc: d.ReconcileFollower,
+	}
+	d.followerReconcileWorker = util.NewAsyncWorker(followerWorkerOptions)
+	d.followerReconcileWorker.Run(1, d.stopCh)
+
+	followerHandler := fedinformer.NewHandlerOnEvents(d.followerReconcileWorker.Enqueue, func(_, newObj interface{}) {
+		d.followerReconcileWorker.Enqueue(newObj)
+	}, d.followerReconcileWorker.Enqueue)
+	d.InformerManager.ForResource(followerGVR, followerHandler)
+	d.followerLister = d.InformerManager.Lister(followerGVR)
+
+	clusterFollowerHandler := fedinformer.NewHandlerOnEvents(d.followerReconcileWorker.Enqueue, func(_, newObj interface{}) {
+		d.followerReconcileWorker.Enqueue(newObj)
+	}, d.followerReconcileWorker.Enqueue)
+	d.InformerManager.ForResource(clusterFollowerGVR, clusterFollowerHandler)
+	d.clusterFollowerLister = d.InformerManager.Lister(clusterFollowerGVR)
+}
+
+// ReconcileFollower rebuilds the follower/leader index entry for a single Follower or ClusterFollower object.
+func (d *ResourceDetector) ReconcileFollower(key util.QueueKey) error {
+	ckey, ok := key.(keys.ClusterWideKey)
+	if !ok {
+		klog.Error("Found invalid key when reconciling follower.")
+		return nil
+	}
+
+	var lister cache.GenericLister
+	if ckey.Namespace != "" {
+		lister = d.followerLister
+	} else {
+		lister = d.clusterFollowerLister
+	}
+
+	obj, err := lister.Get(ckey.NamespaceKey())
+	if err != nil {
+		d.followers.delete(ckey)
+		return nil
+	}
+
+	unstructuredObj, err := helper.ToUnstructured(obj)
+	if err != nil {
+		klog.Errorf("Failed to transform follower object(%s): %v", ckey, err)
+		return err
+	}
+
+	var leaders []policyv1alpha1.FollowerLeaderReference
+	if ckey.Namespace != "" {
+		follower := &policyv1alpha1.Follower{}
+		if err := helper.ConvertToTypedObject(unstructuredObj, follower); err != nil {
+			klog.Errorf("Failed to convert Follower(%s) from unstructured object: %v", ckey, err)
+			return err
+		}
+		leaders = follower.Spec.Leaders
+	} else {
+		clusterFollower := &policyv1alpha1.ClusterFollower{}
+		if err := helper.ConvertToTypedObject(unstructuredObj, clusterFollower); err != nil {
+			klog.Errorf("Failed to convert ClusterFollower(%s) from unstructured object: %v", ckey, err)
+			return err
+		}
+		leaders = clusterFollower.Spec.Leaders
+	}
+
+	leaderKeys := make([]keys.ClusterWideKey, 0, len(leaders))
+	for _, leader := range leaders {
+		gv, err := schema.ParseGroupVersion(leader.APIVersion)
+		if err != nil {
+			klog.Errorf("Failed to parse apiVersion(%s) of leader declared by follower(%s): %v", leader.APIVersion, ckey, err)
+			continue
+		}
+		leaderKeys = append(leaderKeys, keys.ClusterWideKey{
+			Group:     gv.Group,
+			Version:   gv.Version,
+			Kind:      leader.Kind,
+			Namespace: leader.Namespace,
+			Name:      leader.Name,
+		})
+	}
+
+	d.followers.set(ckey, leaderKeys)
+	return nil
+}
+
+// propagateFollowers builds or updates the binding of every follower declared for leaderKey directly from the
+// leader's resolved Placement and permanent-ID label, instead of routing the follower back through ordinary
+// PropagationPolicy/ClusterPropagationPolicy matching, which a follower with no policy of its own would never
+// satisfy and would otherwise fall into waitingObjects.
+func (d *ResourceDetector) propagateFollowers(leaderKey keys.ClusterWideKey) {
+	for _, followerKey := range d.followers.followersOf(leaderKey) {
+		if err := d.propagateFollowerBinding(leaderKey, followerKey); err != nil {
+			klog.Errorf("Failed to propagate follower(%s) of leader(%s): %v", followerKey, leaderKey, err)
+		}
+	}
+}
+
+// leaderBindingPlacement reads the labels and resolved Placement/Failover/ConflictResolution off leaderKey's
+// own ResourceBinding/ClusterResourceBinding, so a follower can be built with exactly the same scheduling
+// inputs as its leader without needing a PropagationPolicy of its own.
+func (d *ResourceDetector) leaderBindingPlacement(leaderKey keys.ClusterWideKey) (labels map[string]string, spec *policyv1alpha1.PropagationSpec, err error) {
+	bindingName := names.GenerateBindingName(leaderKey.Kind, leaderKey.Name)
+
+	if leaderKey.Namespace != "" {
+		leaderBinding := &workv1alpha2.ResourceBinding{}
+		if err := d.Client.Get(context.TODO(), client.ObjectKey{Namespace: leaderKey.Namespace, Name: bindingName}, leaderBinding); err != nil {
+			return nil, nil, err
+		}
+		return leaderBindingLabels(leaderBinding.Labels), leaderBindingSpec(leaderBinding.Spec), nil
+	}
+
+	leaderBinding := &workv1alpha2.ClusterResourceBinding{}
+	if err := d.Client.Get(context.TODO(), client.ObjectKey{Name: bindingName}, leaderBinding); err != nil {
+		return nil, nil, err
+	}
+	return leaderBindingLabels(leaderBinding.Labels), leaderBindingSpec(leaderBinding.Spec), nil
+}
+
+// leaderBindingLabels carries forward only the permanent-ID label(s) that identify which policy claimed the
+// leader, so the follower's binding can be found and cleaned up the same way the leader's is.
+func leaderBindingLabels(bindingLabels map[string]string) map[string]string {
+	followerLabels := make(map[string]string)
+	if id, ok := bindingLabels[policyv1alpha1.PropagationPolicyPermanentIDLabel]; ok {
+		followerLabels[policyv1alpha1.PropagationPolicyPermanentIDLabel] = id
+	}
+	if id, ok := bindingLabels[policyv1alpha1.ClusterPropagationPolicyPermanentIDLabel]; ok {
+		followerLabels[policyv1alpha1.ClusterPropagationPolicyPermanentIDLabel] = id
+	}
+	return followerLabels
+}
+
+// leaderBindingSpec wraps a leader binding's resolved scheduling inputs in a PropagationSpec, so
+// BuildResourceBinding/BuildClusterResourceBinding can be reused unchanged to build the follower's binding.
+func leaderBindingSpec(bindingSpec workv1alpha2.ResourceBindingSpec) *policyv1alpha1.PropagationSpec {
+	spec := &policyv1alpha1.PropagationSpec{
+		ConflictResolution: bindingSpec.ConflictResolution,
+		Failover:           bindingSpec.Failover,
+	}
+	if bindingSpec.Placement != nil {
+		spec.Placement = *bindingSpec.Placement
+	}
+	return spec
+}
+
+// propagateFollowerBinding builds or updates followerKey's binding directly, bypassing ordinary policy
+// matching since the follower was never claimed by a PropagationPolicy/ClusterPropagationPolicy of its own.
+func (d *ResourceDetector) propagateFollowerBinding(leaderKey, followerKey keys.ClusterWideKey) error {
+	followerLabels, leaderSpec, err := d.leaderBindingPlacement(leaderKey)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(4).Infof("Leader(%s) of follower(%s) has no binding yet, skipping for now.", leaderKey, followerKey)
+			return nil
+		}
+		return err
+	}
+
+	object, err := d.GetUnstructuredObject(followerKey)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			d.RemoveWaiting(followerKey)
+			return nil
+		}
+		return err
+	}
+
+	if followerKey.Namespace != "" {
+		binding, err := d.BuildResourceBinding(object, followerLabels, nil, leaderSpec)
+		if err != nil {
+			return err
+		}
+		bindingCopy := binding.DeepCopy()
+		return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			_, err := controllerutil.CreateOrUpdate(context.TODO(), d.Client, bindingCopy, func() error {
+				bindingCopy.Labels = util.DedupeAndMergeLabels(bindingCopy.Labels, binding.Labels)
+				bindingCopy.OwnerReferences = binding.OwnerReferences
+				bindingCopy.Finalizers = binding.Finalizers
+				bindingCopy.Spec.Resource = binding.Spec.Resource
+				bindingCopy.Spec.ReplicaRequirements = binding.Spec.ReplicaRequirements
+				bindingCopy.Spec.Replicas = binding.Spec.Replicas
+				bindingCopy.Spec.Placement = binding.Spec.Placement
+				bindingCopy.Spec.Failover = binding.Spec.Failover
+				bindingCopy.Spec.ConflictResolution = binding.Spec.ConflictResolution
+				bindingCopy.Spec.Followers = binding.Spec.Followers
+				bindingCopy.Spec.Template = binding.Spec.Template
+				return nil
+			})
+			return err
+		})
+	}
+
+	binding, err := d.BuildClusterResourceBinding(object, followerLabels, nil, leaderSpec)
+	if err != nil {
+		return err
+	}
+	bindingCopy := binding.DeepCopy()
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, err := controllerutil.CreateOrUpdate(context.TODO(), d.Client, bindingCopy, func() error {
+			bindingCopy.Labels = util.DedupeAndMergeLabels(bindingCopy.Labels, binding.Labels)
+			bindingCopy.OwnerReferences = binding.OwnerReferences
+			bindingCopy.Finalizers = binding.Finalizers
+			bindingCopy.Spec.Resource = binding.Spec.Resource
+			bindingCopy.Spec.ReplicaRequirements = binding.Spec.ReplicaRequirements
+			bindingCopy.Spec.Replicas = binding.Spec.Replicas
+			bindingCopy.Spec.Placement = binding.Spec.Placement
+			bindingCopy.Spec.Failover = binding.Spec.Failover
+			bindingCopy.Spec.ConflictResolution = binding.Spec.ConflictResolution
+			bindingCopy.Spec.Followers = binding.Spec.Followers
+			bindingCopy.Spec.Template = binding.Spec.Template
+			return nil
+		})
+		return err
+	})
+}
+
+// followerReferences returns the followers declared for leaderKey as ObjectReferences, e.g. for embedding in a
+// FederatedObject's Spec.Followers.
+func (d *ResourceDetector) followerReferences(leaderKey keys.ClusterWideKey) []workv1alpha2.ObjectReference {
+	followerKeys := d.followers.followersOf(leaderKey)
+	if len(followerKeys) == 0 {
+		return nil
+	}
+
+	refs := make([]workv1alpha2.ObjectReference, 0, len(followerKeys))
+	for _, followerKey := range followerKeys {
+		refs = append(refs, workv1alpha2.ObjectReference{
+			APIVersion: schema.GroupVersion{Group: followerKey.Group, Version: followerKey.Version}.String(),
+			Kind:       followerKey.Kind,
+			Namespace:  followerKey.Namespace,
+			Name:       followerKey.Name,
+		})
+	}
+	return refs
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package detector
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/metrics"
+)
+
+// defaultPolicyCleanupConcurrency bounds how many bindings are cleaned up at once when a policy is deleted,
+// if the caller didn't configure ConcurrentPolicyCleanupSyncs.
+const defaultPolicyCleanupConcurrency = 16
+
+// policyCleanupConcurrency returns the configured fan-out for HandlePropagationPolicyDeletion/
+// HandleClusterPropagationPolicyDeletion, falling back to defaultPolicyCleanupConcurrency.
+func (d *ResourceDetector) policyCleanupConcurrency() int {
+	if d.ConcurrentPolicyCleanupSyncs > 0 {
+		return d.ConcurrentPolicyCleanupSyncs
+	}
+	return defaultPolicyCleanupConcurrency
+}
+
+// cleanupResourceBindingsInParallel cleans up the policy marks on rbs and their resource templates using a
+// bounded worker pool, preserving the per-binding invariant that the resource template is cleaned up before
+// the ResourceBinding that references it. Errors from every binding are collected rather than failing fast, so
+// one stuck binding doesn't block the rest of a large policy's bindings from being cleaned up.
+func (d *ResourceDetector) cleanupResourceBindingsInParallel(rbs []workv1alpha2.ResourceBinding, cleanupMarksFunc func(obj metav1.Object)) []error {
+	metrics.PolicyCleanupQueueLength.Add(float64(len(rbs)))
+	start := time.Now()
+
+	var lock sync.Mutex
+	var errs []error
+	workqueue.ParallelizeUntil(context.TODO(), d.policyCleanupConcurrency(), len(rbs), func(i int) {
+		defer metrics.PolicyCleanupQueueLength.Dec()
+		binding := &rbs[i]
+		if err := d.CleanupResourceTemplateMarks(binding.Spec.Resource, cleanupMarksFunc); err != nil {
+			klog.Errorf("Failed to clean up marks from resource(%s-%s/%s) when propagation policy removed, error: %v",
+				binding.Spec.Resource.Kind, binding.Spec.Resource.Namespace, binding.Spec.Resource.Name, err)
+			metrics.CountPolicyCleanupError("resourceTemplate")
+			lock.Lock()
+			errs = append(errs, err)
+			lock.Unlock()
+			// Skip cleaning up policy labels and annotations from the ResourceBinding, give a chance to do that in a retry loop.
+			return
+		}
+
+		// Clean up the marks from the reference binding so that the karmada scheduler won't reschedule the binding.
+		if err := d.CleanupResourceBindingMarks(binding, cleanupMarksFunc); err != nil {
+			klog.Errorf("Failed to clean up marks from resource binding(%s/%s) when propagation policy removed, error: %v",
+				binding.Namespace, binding.Name, err)
+			metrics.CountPolicyCleanupError("resourceBinding")
+			lock.Lock()
+			errs = append(errs, err)
+			lock.Unlock()
+		}
+	})
+
+	metrics.ObservePolicyCleanupDurationAndLatency(utilerrors.NewAggregate(errs), start)
+	return errs
+}
+
+// cleanupClusterResourceBindingsInParallel is the cluster-scoped counterpart of cleanupResourceBindingsInParallel.
+func (d *ResourceDetector) cleanupClusterResourceBindingsInParallel(crbs []workv1alpha2.ClusterResourceBinding, cleanupMarksFunc func(obj metav1.Object)) []error {
+	metrics.PolicyCleanupQueueLength.Add(float64(len(crbs)))
+	start := time.Now()
+
+	var lock sync.Mutex
+	var errs []error
+	workqueue.ParallelizeUntil(context.TODO(), d.policyCleanupConcurrency(), len(crbs), func(i int) {
+		defer metrics.PolicyCleanupQueueLength.Dec()
+		binding := &crbs[i]
+		if err := d.CleanupResourceTemplateMarks(binding.Spec.Resource, cleanupMarksFunc); err != nil {
+			klog.Errorf("Failed to clean up marks from resource(%s-%s) when cluster propagation policy removed, error: %v",
+				binding.Spec.Resource.Kind, binding.Spec.Resource.Name, err)
+			metrics.CountPolicyCleanupError("resourceTemplate")
+			lock.Lock()
+			errs = append(errs, err)
+			lock.Unlock()
+			// Skip cleaning up policy labels and annotations from the ClusterResourceBinding, give a chance to do that in a retry loop.
+			return
+		}
+
+		// Clean up the marks from the reference binding so that the Karmada scheduler won't reschedule the binding.
+		if err := d.CleanupClusterResourceBindingMarks(binding, cleanupMarksFunc); err != nil {
+			klog.Errorf("Failed to clean up marks from clusterResourceBinding(%s) when cluster propagation policy removed, error: %v",
+				binding.Name, err)
+			metrics.CountPolicyCleanupError("clusterResourceBinding")
+			lock.Lock()
+			errs = append(errs, err)
+			lock.Unlock()
+		}
+	})
+
+	metrics.ObservePolicyCleanupDurationAndLatency(utilerrors.NewAggregate(errs), start)
+	return errs
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package detector
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPolicyCleanupConcurrencyDefaultsWhenUnconfigured(t *testing.T) {
+	d := &ResourceDetector{}
+
+	if got := d.policyCleanupConcurrency(); got != defaultPolicyCleanupConcurrency {
+		t.Errorf("expected unconfigured policyCleanupConcurrency() to fall back to %d, got %d", defaultPolicyCleanupConcurrency, got)
+	}
+}
+
+func TestPolicyCleanupConcurrencyUsesConfiguredValue(t *testing.T) {
+	d := &ResourceDetector{ConcurrentPolicyCleanupSyncs: 4}
+
+	if got := d.policyCleanupConcurrency(); got != 4 {
+		t.Errorf("expected policyCleanupConcurrency() to return the configured value 4, got %d", got)
+	}
+}
+
+func TestCleanupResourceBindingsInParallelNoBindings(t *testing.T) {
+	d := &ResourceDetector{}
+
+	errs := d.cleanupResourceBindingsInParallel(nil, func(metav1.Object) {})
+	if len(errs) != 0 {
+		t.Errorf("expected no errors when there are no bindings to clean up, got %v", errs)
+	}
+}
+
+func TestCleanupClusterResourceBindingsInParallelNoBindings(t *testing.T) {
+	d := &ResourceDetector{}
+
+	errs := d.cleanupClusterResourceBindingsInParallel(nil, func(metav1.Object) {})
+	if len(errs) != 0 {
+		t.Errorf("expected no errors when there are no cluster resource bindings to clean up, got %v", errs)
+	}
+}
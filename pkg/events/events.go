@@ -0,0 +1,32 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package events defines the reasons used when recording Kubernetes events against resource templates and
+// bindings, so `kubectl describe` gives operators a consistent vocabulary across controllers.
+package events
+
+const (
+	// EventReasonApplyPolicyFailed is the reason recorded when a resource template fails to be claimed by,
+	// or bound according to, a matched PropagationPolicy/ClusterPropagationPolicy.
+	EventReasonApplyPolicyFailed = "ApplyPolicyFailed"
+	// EventReasonApplyPolicySucceed is the reason recorded when a resource template is successfully claimed
+	// by, and bound according to, a matched PropagationPolicy/ClusterPropagationPolicy.
+	EventReasonApplyPolicySucceed = "ApplyPolicySucceed"
+
+	// EventReasonCleanupResourceTemplateMarksFailed is the reason recorded when the detector fails to strip
+	// the labels/annotations a policy left on a resource template after the policy no longer claims it.
+	EventReasonCleanupResourceTemplateMarksFailed = "CleanupResourceTemplateMarksFailed"
+)
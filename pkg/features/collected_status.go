@@ -0,0 +1,35 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package features
+
+import (
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/component-base/featuregate"
+)
+
+const (
+	// CollectedStatus, if enabled, makes ResourceDetector split per-cluster status out of
+	// ResourceBinding/ClusterResourceBinding.Status into a separate CollectedStatus/ClusterCollectedStatus,
+	// so status writers no longer contend with the scheduler and other spec writers on the binding itself.
+	CollectedStatus featuregate.Feature = "CollectedStatus"
+)
+
+func init() {
+	utilruntime.Must(FeatureGate.Add(map[featuregate.Feature]featuregate.FeatureSpec{
+		CollectedStatus: {Default: false, PreRelease: featuregate.Alpha},
+	}))
+}
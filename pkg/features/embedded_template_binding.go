@@ -0,0 +1,35 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package features
+
+import (
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/component-base/featuregate"
+)
+
+const (
+	// EmbeddedTemplateBinding, if enabled, makes BuildResourceBinding/BuildClusterResourceBinding snapshot the
+	// resource template verbatim into ResourceBinding.Spec.Template instead of only pointing back to it via
+	// Spec.Resource, so the binding itself is enough to re-derive what was scheduled.
+	EmbeddedTemplateBinding featuregate.Feature = "EmbeddedTemplateBinding"
+)
+
+func init() {
+	utilruntime.Must(FeatureGate.Add(map[featuregate.Feature]featuregate.FeatureSpec{
+		EmbeddedTemplateBinding: {Default: false, PreRelease: featuregate.Alpha},
+	}))
+}
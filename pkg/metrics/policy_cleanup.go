@@ -0,0 +1,62 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+// PolicyCleanupQueueLength tracks how many policy-deleted bindings are queued for mark cleanup, so the
+// worker pool backlog shows up on the same dashboards as the other controller queues.
+var PolicyCleanupQueueLength = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name: "karmada_detector_policy_cleanup_queue_length",
+	Help: "Number of resource/cluster resource bindings queued for policy mark cleanup.",
+})
+
+var policyCleanupErrorCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "karmada_detector_policy_cleanup_error_total",
+	Help: "Number of errors encountered while cleaning up marks left by a deleted policy, by phase.",
+}, []string{"phase"})
+
+var policyCleanupDurationAndLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "karmada_detector_policy_cleanup_duration_seconds",
+	Help:    "Duration in seconds of cleaning up marks left by a deleted policy, by result.",
+	Buckets: prometheus.DefBuckets,
+}, []string{"result"})
+
+func init() {
+	legacyregistry.MustRegister(PolicyCleanupQueueLength, policyCleanupErrorCount, policyCleanupDurationAndLatency)
+}
+
+// CountPolicyCleanupError increments the cleanup error count for the given phase (e.g. "resourceTemplate",
+// "resourceBinding", "clusterResourceBinding").
+func CountPolicyCleanupError(phase string) {
+	policyCleanupErrorCount.WithLabelValues(phase).Inc()
+}
+
+// ObservePolicyCleanupDurationAndLatency records how long a policy mark cleanup took, labeled by whether it
+// ultimately succeeded or failed.
+func ObservePolicyCleanupDurationAndLatency(err error, start time.Time) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	policyCleanupDurationAndLatency.WithLabelValues(result).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceinterpreter
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+// ResourceInterpreter manages the built-in, Lua-customized and webhook interpreters for resource templates,
+// and exposes the hooks ResourceDetector needs to turn a resource template into a ResourceBinding.
+type ResourceInterpreter interface {
+	// HookEnabled tells if a hook for the given operation is enabled for the given GVK, by any of the
+	// built-in, Lua-customized, or webhook interpreters.
+	HookEnabled(gvk schema.GroupVersionKind, operation configv1alpha1.InterpreterOperation) bool
+
+	// GetReplicas returns the desired replica number and the resource requirements of each replica,
+	// declared by object.
+	GetReplicas(object *unstructured.Unstructured) (replicas int32, requires *workv1alpha2.ReplicaRequirements, err error)
+
+	// GetFollowers returns the other resource templates that should be propagated to the same clusters as
+	// object.
+	GetFollowers(object *unstructured.Unstructured) ([]workv1alpha2.ObjectReference, error)
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package native provides the built-in (non-Lua, non-webhook) ResourceInterpreter hooks for the handful of
+// native Kubernetes workload kinds Karmada understands out of the box.
+package native
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+// nativePodSpecKinds are the built-in workload kinds whose ConfigMap/Secret dependencies GetFollowers knows
+// how to read straight out of a pod template, without needing a Lua script or webhook.
+var nativePodSpecKinds = map[string]bool{
+	"Deployment":  true,
+	"StatefulSet": true,
+	"DaemonSet":   true,
+	"Job":         true,
+	"Pod":         true,
+}
+
+// GetFollowers returns the ConfigMaps and Secrets referenced by object's pod template (volumes, envFrom, env)
+// as followers, for the native workload kinds Karmada interprets without a Lua script or webhook. It returns
+// no followers, and no error, for any other kind.
+func GetFollowers(object *unstructured.Unstructured) ([]workv1alpha2.ObjectReference, error) {
+	if !nativePodSpecKinds[object.GetKind()] {
+		return nil, nil
+	}
+
+	podSpec, err := extractPodSpec(object)
+	if err != nil {
+		return nil, err
+	}
+	if podSpec == nil {
+		return nil, nil
+	}
+
+	namespace := object.GetNamespace()
+	seen := make(map[workv1alpha2.ObjectReference]bool)
+	var followers []workv1alpha2.ObjectReference
+
+	add := func(kind, name string) {
+		if name == "" {
+			return
+		}
+		ref := workv1alpha2.ObjectReference{APIVersion: "v1", Kind: kind, Namespace: namespace, Name: name}
+		if seen[ref] {
+			return
+		}
+		seen[ref] = true
+		followers = append(followers, ref)
+	}
+
+	for _, volume := range podSpec.Volumes {
+		if volume.ConfigMap != nil {
+			add("ConfigMap", volume.ConfigMap.Name)
+		}
+		if volume.Secret != nil {
+			add("Secret", volume.Secret.SecretName)
+		}
+	}
+
+	for _, container := range append(append([]corev1.Container{}, podSpec.InitContainers...), podSpec.Containers...) {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				add("ConfigMap", envFrom.ConfigMapRef.Name)
+			}
+			if envFrom.SecretRef != nil {
+				add("Secret", envFrom.SecretRef.Name)
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if env.ValueFrom.ConfigMapKeyRef != nil {
+				add("ConfigMap", env.ValueFrom.ConfigMapKeyRef.Name)
+			}
+			if env.ValueFrom.SecretKeyRef != nil {
+				add("Secret", env.ValueFrom.SecretKeyRef.Name)
+			}
+		}
+	}
+
+	return followers, nil
+}
+
+// extractPodSpec pulls the embedded corev1.PodSpec out of object's spec.template.spec (or spec, for a bare Pod),
+// returning nil if object has nothing at that path.
+func extractPodSpec(object *unstructured.Unstructured) (*corev1.PodSpec, error) {
+	path := []string{"spec", "template", "spec"}
+	if object.GetKind() == "Pod" {
+		path = []string{"spec"}
+	}
+
+	rawSpec, found, err := unstructured.NestedMap(object.Object, path...)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	podSpec := &corev1.PodSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawSpec, podSpec); err != nil {
+		return nil, err
+	}
+	return podSpec, nil
+}